@@ -0,0 +1,116 @@
+// Command go_to_c reads newline-separated Fahrenheit temperatures from stdin
+// and prints each one converted to Celsius, or to another scale given with
+// -scale (for example -scale=k), one per line.
+//
+//	cat temps.txt | go_to_c -scale=c
+//
+// Lines that cannot be converted are reported on stderr with their line number
+// and skipped; the exit status is 1 if any line was skipped.
+//
+// With -csv-col the input is read as CSV instead, and only the given
+// zero-based column is converted:
+//
+//	go_to_c -csv-col=2 -header < readings.csv
+//
+// A cell that cannot be converted stops the conversion with an error naming
+// its row, unless -skip-errors is given, in which case it is left as is.
+//
+// The table subcommand prints a reference table instead of reading stdin:
+//
+//	go_to_c table --from F --to C --start 0 --end 100 --step 10 --format md
+//
+// --format is plain (aligned columns, the default), csv or md. The range must
+// have start no greater than end and a positive step, and give at most a
+// million rows.
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/avr1/lsd_ceph/go_to_c/tempconv"
+)
+
+func main() {
+	if err := tempconv.SelfCheck(); err != nil {
+		fmt.Fprintln(os.Stderr, "go_to_c:", err)
+		os.Exit(1)
+	}
+	if len(os.Args) > 1 && os.Args[1] == "table" {
+		if err := runTable(os.Args[2:], os.Stdout, os.Stderr); err != nil {
+			if err != flag.ErrHelp {
+				fmt.Fprintln(os.Stderr, "go_to_c:", err)
+			}
+			os.Exit(1)
+		}
+		return
+	}
+
+	to := tempconv.Celsius
+	flag.Var(&to, "scale", "output `scale`, e.g. c (Celsius), k (Kelvin) or f")
+	csvCol := flag.Int("csv-col", -1, "read CSV and convert this zero-based column")
+	header := flag.Bool("header", false, "with -csv-col, pass the first row through unchanged")
+	skipErrors := flag.Bool("skip-errors", false, "with -csv-col, leave cells that cannot be converted as they are")
+	flag.Parse()
+
+	if *csvCol >= 0 {
+		c := tempconv.CSVConverter{
+			Column:     *csvCol,
+			From:       tempconv.Fahrenheit,
+			To:         to,
+			Header:     *header,
+			SkipErrors: *skipErrors,
+		}
+		if err := c.Convert(os.Stdin, os.Stdout); err != nil {
+			fmt.Fprintln(os.Stderr, "go_to_c:", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	ok, err := run(os.Stdin, os.Stdout, os.Stderr, to)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "go_to_c:", err)
+		os.Exit(1)
+	}
+	if !ok {
+		os.Exit(1)
+	}
+}
+
+// run converts every line of r from Fahrenheit to the to scale and writes the
+// results to w. Bad lines are reported to errw and skipped; ok is false if
+// there were any. err is only set when reading r or writing w fails.
+func run(r io.Reader, w, errw io.Writer, to tempconv.Scale) (ok bool, err error) {
+	ok = true
+	out := bufio.NewWriter(w)
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+		f, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			fmt.Fprintf(errw, "line %d: invalid temperature %q\n", line, text)
+			ok = false
+			continue
+		}
+		v, err := tempconv.Convert(f, tempconv.Fahrenheit, to)
+		if err != nil {
+			fmt.Fprintf(errw, "line %d: %v\n", line, err)
+			ok = false
+			continue
+		}
+		fmt.Fprintln(out, v)
+	}
+	if err := scanner.Err(); err != nil {
+		return ok, err
+	}
+	return ok, out.Flush()
+}
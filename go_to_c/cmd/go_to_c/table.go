@@ -0,0 +1,127 @@
+package main
+
+import (
+	"bufio"
+	"encoding/csv"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/avr1/lsd_ceph/go_to_c/tempconv"
+)
+
+// runTable implements the table subcommand, which prints a reference table of
+// conversions:
+//
+//	go_to_c table --from F --to C --start 0 --end 100 --step 10 --format md
+//
+// args are the arguments after "table".
+func runTable(args []string, w, errw io.Writer) error {
+	fs := flag.NewFlagSet("table", flag.ContinueOnError)
+	fs.SetOutput(errw)
+	from, to := tempconv.Fahrenheit, tempconv.Celsius
+	fs.Var(&from, "from", "input `scale`")
+	fs.Var(&to, "to", "output `scale`")
+	start := fs.Float64("start", 0, "first input value")
+	end := fs.Float64("end", 100, "last input value")
+	step := fs.Float64("step", 10, "difference between rows, greater than zero")
+	format := fs.String("format", "plain", "output `format`: plain, csv or md")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 {
+		return fmt.Errorf("table: unexpected argument %q", fs.Arg(0))
+	}
+
+	write, ok := tableWriters[*format]
+	if !ok {
+		return fmt.Errorf("table: unknown format %q, want plain, csv or md", *format)
+	}
+	rows, err := tableRows(*start, *end, *step, from, to)
+	if err != nil {
+		return err
+	}
+	return write(w, [2]string{from.String(), to.String()}, rows)
+}
+
+// tableWriters maps each --format value to the function that renders it.
+var tableWriters = map[string]func(w io.Writer, header [2]string, rows [][2]string) error{
+	"plain": writePlainTable,
+	"csv":   writeCSVTable,
+	"md":    writeMarkdownTable,
+}
+
+// maxTableRows is the most rows the table subcommand prints, so a huge range
+// or tiny step fails with an error instead of exhausting memory.
+const maxTableRows = 1000000
+
+// tableRows converts start, start+step, ... up to and including end, and
+// returns each input next to its result, both formatted to two decimals.
+func tableRows(start, end, step float64, from, to tempconv.Scale) ([][2]string, error) {
+	if math.IsNaN(start) || math.IsInf(start, 0) || math.IsNaN(end) || math.IsInf(end, 0) {
+		return nil, errors.New("table: start and end must be finite numbers")
+	}
+	if start > end {
+		return nil, fmt.Errorf("table: start %v is greater than end %v", start, end)
+	}
+	if !(step > 0) || math.IsInf(step, 0) {
+		return nil, fmt.Errorf("table: step must be a positive number, got %v", step)
+	}
+
+	// end-start can overflow to +Inf even though both are finite.
+	steps := (end - start) / step
+	if math.IsInf(steps, 0) || steps >= maxTableRows {
+		return nil, fmt.Errorf("table: range %v to %v in steps of %v has more than %d rows", start, end, step, maxTableRows)
+	}
+
+	// Each row is computed from its index rather than by adding step
+	// repeatedly, so rounding does not build up or drop the last row.
+	n := int(math.Floor(steps+1e-9)) + 1
+	rows := make([][2]string, 0, n)
+	for i := 0; i < n; i++ {
+		v := start + float64(i)*step
+		r, err := tempconv.Convert(v, from, to)
+		if err != nil {
+			return nil, fmt.Errorf("table: %v: %w", v, err)
+		}
+		rows = append(rows, [2]string{formatCell(v), formatCell(r)})
+	}
+	return rows, nil
+}
+
+func formatCell(v float64) string {
+	return strconv.FormatFloat(v, 'f', 2, 64)
+}
+
+func writePlainTable(w io.Writer, header [2]string, rows [][2]string) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintf(tw, "%s\t%s\t\n", header[0], header[1])
+	for _, row := range rows {
+		fmt.Fprintf(tw, "%s\t%s\t\n", row[0], row[1])
+	}
+	return tw.Flush()
+}
+
+func writeCSVTable(w io.Writer, header [2]string, rows [][2]string) error {
+	cw := csv.NewWriter(w)
+	cw.Write(header[:])
+	for _, row := range rows {
+		cw.Write(row[:])
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func writeMarkdownTable(w io.Writer, header [2]string, rows [][2]string) error {
+	out := bufio.NewWriter(w)
+	fmt.Fprintf(out, "| %s | %s |\n", header[0], header[1])
+	fmt.Fprintln(out, "| ---: | ---: |")
+	for _, row := range rows {
+		fmt.Fprintf(out, "| %s | %s |\n", row[0], row[1])
+	}
+	return out.Flush()
+}
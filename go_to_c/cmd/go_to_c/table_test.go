@@ -0,0 +1,63 @@
+package main
+
+import (
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestRunTable(t *testing.T) {
+	args := []string{"--from", "F", "--to", "C", "--start", "32", "--end", "212", "--step", "90"}
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{"plain", "" +
+			"       F       C\n" +
+			"   32.00    0.00\n" +
+			"  122.00   50.00\n" +
+			"  212.00  100.00\n"},
+		{"csv", "F,C\n32.00,0.00\n122.00,50.00\n212.00,100.00\n"},
+		{"md", "" +
+			"| F | C |\n" +
+			"| ---: | ---: |\n" +
+			"| 32.00 | 0.00 |\n" +
+			"| 122.00 | 50.00 |\n" +
+			"| 212.00 | 100.00 |\n"},
+	}
+	for _, tt := range tests {
+		var out strings.Builder
+		if err := runTable(append(args, "--format", tt.format), &out, io.Discard); err != nil {
+			t.Errorf("table --format %s: %v", tt.format, err)
+			continue
+		}
+		if out.String() != tt.want {
+			t.Errorf("table --format %s =\n%s\nwant\n%s", tt.format, out.String(), tt.want)
+		}
+	}
+}
+
+func TestRunTableErrors(t *testing.T) {
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{[]string{"--start", "10", "--end", "0"}, "table: start 10 is greater than end 0"},
+		{[]string{"--step", "0"}, "table: step must be a positive number, got 0"},
+		{[]string{"--step", "-1"}, "table: step must be a positive number, got -1"},
+		{[]string{"--start", "0", "--end", "1000000", "--step", "1"}, "table: range 0 to 1e+06 in steps of 1 has more than 1000000 rows"},
+		{[]string{"--format", "html"}, `table: unknown format "html", want plain, csv or md`},
+		{[]string{"--start", "-500"}, "table: -500: tempconv: temperature below absolute zero"},
+		{[]string{"extra"}, `table: unexpected argument "extra"`},
+	}
+	for _, tt := range tests {
+		err := runTable(tt.args, io.Discard, io.Discard)
+		if err == nil || err.Error() != tt.want {
+			t.Errorf("table %v error = %v, want %q", tt.args, err, tt.want)
+		}
+	}
+	// The last row that fits.
+	if err := runTable([]string{"--start", "0", "--end", "999999", "--step", "1"}, io.Discard, io.Discard); err != nil {
+		t.Errorf("table with %d rows: %v", maxTableRows, err)
+	}
+}
@@ -0,0 +1,32 @@
+// Command libtempconv exports the tempconv conversions to C. It is meant to be
+// built as a shared library rather than run:
+//
+//	go build -buildmode=c-shared -o libtempconv.so ./cmd/libtempconv
+//
+// which also writes libtempconv.h declaring the exported functions.
+//
+// The exported functions keep no state between calls, so C code may call
+// them concurrently from any number of threads.
+package main
+
+import "C"
+
+import (
+	"math"
+
+	"github.com/avr1/lsd_ceph/go_to_c/tempconv"
+)
+
+// FToC converts a temperature in Fahrenheit to Celsius. It returns NaN if f is
+// below absolute zero.
+//
+//export FToC
+func FToC(f C.double) C.double {
+	c, err := tempconv.FToC(float64(f))
+	if err != nil {
+		return C.double(math.NaN())
+	}
+	return C.double(c)
+}
+
+func main() {}
@@ -0,0 +1,40 @@
+//go:build js && wasm
+
+// Command wasm exposes the converter to JavaScript when built for the browser:
+//
+//	GOOS=js GOARCH=wasm go build -o tempconv.wasm ./cmd/wasm
+//
+// Once loaded with wasm_exec.js it defines a global FToC(f) function taking
+// and returning a number. Inputs that cannot be converted, such as
+// temperatures below absolute zero or non-numbers, give NaN. cgo is not
+// available for js/wasm, so this always uses the pure-Go conversions.
+package main
+
+import (
+	"math"
+	"syscall/js"
+
+	"github.com/avr1/lsd_ceph/go_to_c/tempconv"
+)
+
+func fToC(this js.Value, args []js.Value) interface{} {
+	if len(args) != 1 || args[0].Type() != js.TypeNumber {
+		return math.NaN()
+	}
+	c, err := tempconv.FToC(args[0].Float())
+	if err != nil {
+		return math.NaN()
+	}
+	return c
+}
+
+// register defines the global FToC function.
+func register() {
+	js.Global().Set("FToC", js.FuncOf(fToC))
+}
+
+func main() {
+	register()
+	// Keep the program alive so JavaScript can keep calling FToC.
+	select {}
+}
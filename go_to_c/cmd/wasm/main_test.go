@@ -0,0 +1,29 @@
+//go:build js && wasm
+
+package main
+
+import (
+	"math"
+	"syscall/js"
+	"testing"
+)
+
+// Run with the wasm exec runner that ships with Go:
+//
+//	GOOS=js GOARCH=wasm go test -exec="$(go env GOROOT)/lib/wasm/go_js_wasm_exec" ./go_to_c/cmd/wasm
+
+func TestFToC(t *testing.T) {
+	register()
+	fn := js.Global().Get("FToC")
+	if got := fn.Invoke(212).Float(); got != 100 {
+		t.Errorf("FToC(212) = %v, want 100", got)
+	}
+	for _, arg := range []any{-500, "212", js.Null()} {
+		if got := fn.Invoke(arg).Float(); !math.IsNaN(got) {
+			t.Errorf("FToC(%v) = %v, want NaN", arg, got)
+		}
+	}
+	if got := fn.Invoke().Float(); !math.IsNaN(got) {
+		t.Errorf("FToC() = %v, want NaN", got)
+	}
+}
@@ -1,6 +0,0 @@
-#ifndef _TEMP_CONVERTER
-#define _TEMP_CONVERTER
-
-double f_to_c(int fahr);
-
-#endif
@@ -0,0 +1,43 @@
+package tempconv
+
+import "fmt"
+
+// SelfCheckError reports that the conversion code gave a wrong answer for a
+// known input when the package was initialized, for example because a
+// tempconv_clib build linked a stale or mismatched libtempconv.a. A missing
+// C symbol cannot be caught this way: cgo binaries resolve f_to_c when they
+// are linked, so such a build fails before it can run.
+type SelfCheckError struct {
+	// Fahrenheit is the input checked, and Got and Want the Celsius value
+	// returned and expected.
+	Fahrenheit, Got, Want float64
+}
+
+func (e *SelfCheckError) Error() string {
+	return fmt.Sprintf("tempconv: self-check failed: f_to_c(%v) = %v, want %v", e.Fahrenheit, e.Got, e.Want)
+}
+
+// selfCheckErr is set once during initialization and only read afterwards.
+var selfCheckErr = selfCheck()
+
+func selfCheck() error {
+	const f, want = 32, 0
+	if got := fToC(f); got != want {
+		return &SelfCheckError{Fahrenheit: f, Got: got, Want: want}
+	}
+	return nil
+}
+
+// Available reports whether the conversion code passed the check run when the
+// package was initialized, converting 32°F and expecting exactly 0°C. When it
+// returns false, SelfCheck gives the details and conversion results should
+// not be trusted.
+func Available() bool {
+	return selfCheckErr == nil
+}
+
+// SelfCheck returns the *SelfCheckError recorded during initialization, or
+// nil if the check passed.
+func SelfCheck() error {
+	return selfCheckErr
+}
@@ -0,0 +1,29 @@
+package tempconv
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestAvailable(t *testing.T) {
+	if !Available() {
+		t.Fatalf("Available() = false: %v", SelfCheck())
+	}
+	if err := SelfCheck(); err != nil {
+		t.Errorf("SelfCheck() = %v, want nil", err)
+	}
+	if got := fToC(32); got != 0 {
+		t.Errorf("f_to_c(32) = %v, want 0", got)
+	}
+}
+
+func TestSelfCheckError(t *testing.T) {
+	var err error = &SelfCheckError{Fahrenheit: 32, Got: 1, Want: 0}
+	if want := "tempconv: self-check failed: f_to_c(32) = 1, want 0"; err.Error() != want {
+		t.Errorf("Error() = %q, want %q", err, want)
+	}
+	var sce *SelfCheckError
+	if !errors.As(err, &sce) || sce.Got != 1 {
+		t.Errorf("errors.As(%v) = %+v, want the SelfCheckError", err, sce)
+	}
+}
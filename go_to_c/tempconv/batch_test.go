@@ -0,0 +1,118 @@
+package tempconv
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strconv"
+	"testing"
+)
+
+func TestFToCBatchCtx(t *testing.T) {
+	in := []float64{32, 212, -40}
+	got, err := FToCBatchCtx(context.Background(), in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, want := range FToCBatch(in) {
+		if got[i] != want {
+			t.Errorf("FToCBatchCtx(%v)[%d] = %v, want %v", in[i], i, got[i], want)
+		}
+	}
+}
+
+func TestFToCBatchCtxCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	for _, in := range [][]float64{nil, {}, {32}, make([]float64, 3*batchChunk)} {
+		out, err := FToCBatchCtx(ctx, in)
+		if !errors.Is(err, context.Canceled) || out != nil {
+			t.Errorf("FToCBatchCtx(canceled, %d values) = %v, %v, want nil, context.Canceled", len(in), out, err)
+		}
+	}
+}
+
+// cancelAfter is a context whose Err starts reporting context.Canceled after
+// it has been called n times.
+type cancelAfter struct {
+	context.Context
+	n int
+}
+
+func (c *cancelAfter) Err() error {
+	if c.n == 0 {
+		return context.Canceled
+	}
+	c.n--
+	return nil
+}
+
+func TestFToCBatchCtxCanceledMidway(t *testing.T) {
+	ctx := &cancelAfter{Context: context.Background(), n: 2}
+	out, err := FToCBatchCtx(ctx, make([]float64, 3*batchChunk))
+	if !errors.Is(err, context.Canceled) || out != nil {
+		t.Errorf("FToCBatchCtx canceled after two chunks = %d values, %v, want nil, context.Canceled", len(out), err)
+	}
+}
+
+func TestFToCInPlace(t *testing.T) {
+	vals := benchInput(10000)
+	want := FToCBatch(vals)
+	FToCInPlace(vals)
+	for i := range vals {
+		if math.Float64bits(vals[i]) != math.Float64bits(want[i]) {
+			t.Fatalf("FToCInPlace: vals[%d] = %v, want %v", i, vals[i], want[i])
+		}
+	}
+	FToCInPlace(nil)
+
+	if allocs := testing.AllocsPerRun(100, func() { FToCInPlace(vals) }); allocs != 0 {
+		t.Errorf("FToCInPlace allocated %v times per call, want 0", allocs)
+	}
+}
+
+// BenchmarkBatch compares the allocating FToCBatch with FToCInPlace; run it
+// with -benchmem to see the difference.
+func BenchmarkBatch(b *testing.B) {
+	for _, n := range benchSizes {
+		in := benchInput(n)
+		b.Run("batch/"+strconv.Itoa(n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				sink = FToCBatch(in)[0]
+			}
+		})
+		b.Run("inplace/"+strconv.Itoa(n), func(b *testing.B) {
+			b.ReportAllocs()
+			vals := make([]float64, n)
+			for i := 0; i < b.N; i++ {
+				copy(vals, in)
+				FToCInPlace(vals)
+			}
+		})
+	}
+}
+
+// TestFToCBatchMatchesFToC checks that f_to_c_batch gives bit-for-bit the
+// results of calling f_to_c on each value, from absolute zero to well past
+// boiling and across more than one chunk.
+func TestFToCBatchMatchesFToC(t *testing.T) {
+	in := make([]float64, 3*batchChunk+7)
+	for i := range in {
+		in[i] = AbsoluteZeroF + float64(i)*0.37
+	}
+	out := FToCBatch(in)
+	ctxOut, err := FToCBatchCtx(context.Background(), in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, f := range in {
+		want := math.Float64bits(fToC(f))
+		if got := math.Float64bits(out[i]); got != want {
+			t.Fatalf("FToCBatch: f_to_c_batch(%v) = %#x, f_to_c gives %#x", f, got, want)
+		}
+		if got := math.Float64bits(ctxOut[i]); got != want {
+			t.Fatalf("FToCBatchCtx: f_to_c_batch(%v) = %#x, f_to_c gives %#x", f, got, want)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package tempconv
+
+import (
+	"strconv"
+	"testing"
+)
+
+// Benchmarks for the cost of the cgo boundary. With cgo enabled fToC calls
+// C.f_to_c; goFToC is the same arithmetic in Go. Run with
+//
+//	go test -bench=. ./tempconv
+//
+// and compare BenchmarkFToC/cgo with BenchmarkFToC/go for per-call latency,
+// and the Loop benchmarks for the throughput of a whole slice. Under
+// CGO_ENABLED=0 both sides run Go code.
+
+func goFToC(f float64) float64 {
+	return ((f - 32) * 5.0) / 9.0
+}
+
+var sink float64
+
+func BenchmarkFToC(b *testing.B) {
+	b.Run("cgo", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			sink = fToC(float64(i))
+		}
+	})
+	b.Run("go", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			sink = goFToC(float64(i))
+		}
+	})
+}
+
+// benchSizes are the slice lengths the Loop benchmarks convert; the last is
+// the million-value throughput case.
+var benchSizes = []int{1, 1000, 1000000}
+
+func benchInput(n int) []float64 {
+	in := make([]float64, n)
+	for i := range in {
+		in[i] = float64(i%1000) - 40
+	}
+	return in
+}
+
+func BenchmarkLoop(b *testing.B) {
+	for _, n := range benchSizes {
+		in := benchInput(n)
+		out := make([]float64, n)
+		b.Run("cgo/"+strconv.Itoa(n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				for j, f := range in {
+					out[j] = fToC(f)
+				}
+			}
+		})
+		b.Run("go/"+strconv.Itoa(n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				for j, f := range in {
+					out[j] = goFToC(f)
+				}
+			}
+		})
+		b.Run("batch/"+strconv.Itoa(n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				fToCBatch(in, out)
+			}
+		})
+	}
+}
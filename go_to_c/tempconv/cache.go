@@ -0,0 +1,74 @@
+package tempconv
+
+import (
+	"fmt"
+	"math"
+	"sync/atomic"
+)
+
+// Default range of the FToCCached lookup table, covering typical room and
+// weather temperatures.
+const (
+	DefaultCacheMin = 32
+	DefaultCacheMax = 120
+)
+
+// MaxCacheEntries is the most whole degrees SetFToCCacheRange accepts, which
+// keeps the table to 32 MiB.
+const MaxCacheEntries = 1 << 22
+
+// lookupTable holds f_to_c results for the integers min..max. A table is
+// never modified once published, so readers need no locking.
+type lookupTable struct {
+	min, max int
+	celsius  []float64
+}
+
+var cache atomic.Value // *lookupTable
+
+func init() {
+	cache.Store(newLookupTable(DefaultCacheMin, DefaultCacheMax))
+}
+
+func newLookupTable(min, max int) *lookupTable {
+	t := &lookupTable{min: min, max: max, celsius: make([]float64, max-min+1)}
+	for i := range t.celsius {
+		t.celsius[i] = fToC(float64(min + i))
+	}
+	return t
+}
+
+// SetFToCCacheRange replaces the FToCCached lookup table with one covering the
+// whole Fahrenheit degrees min through max. It is safe to call while other
+// goroutines are converting; they see either the old table or the new one.
+// The range may hold at most MaxCacheEntries degrees, and both ends must be
+// within ±MaxMagnitude, where every whole degree is still a distinct float64.
+func SetFToCCacheRange(min, max int) error {
+	if max < min {
+		return fmt.Errorf("tempconv: invalid cache range %d..%d", min, max)
+	}
+	// Compared as int64 so the check also compiles where int is 32 bits.
+	if int64(min) < -MaxMagnitude || int64(max) > MaxMagnitude {
+		return fmt.Errorf("tempconv: cache range %d..%d is outside ±%d", min, max, int64(MaxMagnitude))
+	}
+	// Subtracting as unsigned cannot overflow, unlike max-min+1.
+	if uint64(max)-uint64(min) >= MaxCacheEntries {
+		return fmt.Errorf("tempconv: cache range %d..%d has more than %d entries", min, max, MaxCacheEntries)
+	}
+	cache.Store(newLookupTable(min, max))
+	return nil
+}
+
+// FToCCached converts a temperature in Fahrenheit to Celsius, answering whole
+// degrees inside the cache range (DefaultCacheMin to DefaultCacheMax unless
+// changed with SetFToCCacheRange) from a precomputed table without calling
+// into C. Other inputs are converted with f_to_c directly. Results are
+// identical to FToC's, but like FToCBatch, FToCCached does not validate its
+// input.
+func FToCCached(f float64) float64 {
+	t := cache.Load().(*lookupTable)
+	if f >= float64(t.min) && f <= float64(t.max) && f == math.Trunc(f) {
+		return t.celsius[int(f)-t.min]
+	}
+	return fToC(f)
+}
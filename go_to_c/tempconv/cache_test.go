@@ -0,0 +1,90 @@
+package tempconv
+
+import (
+	"math"
+	"strconv"
+	"testing"
+)
+
+// resetCache restores the default FToCCached range when t finishes.
+func resetCache(t testing.TB) {
+	t.Cleanup(func() {
+		if err := SetFToCCacheRange(DefaultCacheMin, DefaultCacheMax); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestSetFToCCacheRangeRejects(t *testing.T) {
+	resetCache(t)
+	ranges := [][2]int{
+		{10, 5},
+		{0, MaxCacheEntries},
+	}
+	if strconv.IntSize == 64 {
+		ranges = append(ranges,
+			[2]int{math.MaxInt - 2, math.MaxInt},
+			[2]int{math.MinInt, math.MinInt + 2},
+			[2]int{MaxMagnitude - 2, MaxMagnitude + 1},
+			[2]int{-MaxMagnitude - 1, -MaxMagnitude + 1},
+		)
+	}
+	for _, r := range ranges {
+		if err := SetFToCCacheRange(r[0], r[1]); err == nil {
+			t.Errorf("SetFToCCacheRange(%d, %d) succeeded, want error", r[0], r[1])
+		}
+	}
+}
+
+// TestFToCCachedMatchesFToC checks the cached answers are the ones fToC
+// gives, for whole and fractional degrees inside and around the range,
+// including ranges at the ±MaxMagnitude limits.
+func TestFToCCachedMatchesFToC(t *testing.T) {
+	resetCache(t)
+	ranges := [][2]int{
+		{DefaultCacheMin, DefaultCacheMax},
+		{-500, 500},
+		{0, MaxCacheEntries - 1},
+	}
+	if strconv.IntSize == 64 {
+		ranges = append(ranges,
+			[2]int{MaxMagnitude - 2, MaxMagnitude},
+			[2]int{-MaxMagnitude, -MaxMagnitude + 2},
+		)
+	}
+	for _, r := range ranges {
+		if err := SetFToCCacheRange(r[0], r[1]); err != nil {
+			t.Fatalf("SetFToCCacheRange(%d, %d): %v", r[0], r[1], err)
+		}
+		// Counted rather than stepped, since near MaxMagnitude adding a
+		// fraction to f can leave it unchanged.
+		lo, hi := float64(r[0]), float64(r[1])
+		const n = 1000
+		var inputs []float64
+		for i := -10; i <= n+10; i++ {
+			inputs = append(inputs, lo+(hi-lo)*float64(i)/n, lo+float64(i)/2)
+		}
+		for _, f := range inputs {
+			if got, want := FToCCached(f), fToC(f); math.Float64bits(got) != math.Float64bits(want) {
+				t.Errorf("range %d..%d: FToCCached(%v) = %v, want %v", r[0], r[1], f, got, want)
+			}
+		}
+	}
+}
+
+func BenchmarkFToCCached(b *testing.B) {
+	in := make([]float64, DefaultCacheMax-DefaultCacheMin+1)
+	for i := range in {
+		in[i] = float64(DefaultCacheMin + i)
+	}
+	b.Run("cached", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			sink = FToCCached(in[i%len(in)])
+		}
+	})
+	b.Run("uncached", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			sink = fToC(in[i%len(in)])
+		}
+	})
+}
@@ -0,0 +1,34 @@
+package tempconv
+
+import (
+	"math"
+	"sync"
+	"testing"
+)
+
+// TestConcurrentFToC calls FToC from many goroutines at once and checks every
+// result. Run it with -race to check the package's shared state too.
+func TestConcurrentFToC(t *testing.T) {
+	const (
+		goroutines = 64
+		calls      = 1000
+		epsilon    = 1e-9
+	)
+	var wg sync.WaitGroup
+	for g := range goroutines {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range calls {
+				f := float64(g*calls + i)
+				want := (f - 32) * 5 / 9
+				got, err := FToC(f)
+				if err != nil || math.Abs(got-want) > epsilon*math.Max(1, math.Abs(want)) {
+					t.Errorf("FToC(%v) = %v, %v, want %v", f, got, err, want)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
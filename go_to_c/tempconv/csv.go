@@ -0,0 +1,91 @@
+package tempconv
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// CSVConverter converts one column of a CSV stream between scales, leaving
+// every other column untouched.
+type CSVConverter struct {
+	// Column is the zero-based index of the column holding temperatures.
+	Column int
+	From   Scale
+	To     Scale
+
+	// Header passes the first row through unchanged.
+	Header bool
+
+	// SkipErrors leaves cells that cannot be converted, and rows too short
+	// to have the column, as they are instead of failing.
+	SkipErrors bool
+}
+
+// ErrMissingColumn is returned by CSVConverter.Convert for a row too short to
+// have the temperature column.
+var ErrMissingColumn = errors.New("tempconv: missing CSV column")
+
+// ConvertCSV reads CSV rows from r, converts column col (zero-based) from one
+// scale to another and writes the rows to w. It fails on the first cell that
+// cannot be converted; use CSVConverter for a header row or to skip bad
+// cells.
+func ConvertCSV(r io.Reader, w io.Writer, col int, from, to Scale) error {
+	return CSVConverter{Column: col, From: from, To: to}.Convert(r, w)
+}
+
+// Convert reads CSV rows from r and writes them to w with c.Column converted.
+// Errors name the offending row, counting from 1 and including any header;
+// the rows before it have already been written to w.
+func (c CSVConverter) Convert(r io.Reader, w io.Writer) error {
+	if c.Column < 0 {
+		return fmt.Errorf("tempconv: negative CSV column %d", c.Column)
+	}
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cw := csv.NewWriter(w)
+	// Flush on every return so rows before an error still reach w.
+	defer cw.Flush()
+
+	for row := 1; ; row++ {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if row > 1 || !c.Header {
+			if err := c.convertRecord(record, row); err != nil && !c.SkipErrors {
+				return err
+			}
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// convertRecord replaces the temperature in record in place. On error record
+// is left unchanged.
+func (c CSVConverter) convertRecord(record []string, row int) error {
+	if c.Column >= len(record) {
+		return fmt.Errorf("row %d: %w %d", row, ErrMissingColumn, c.Column)
+	}
+	cell := strings.TrimSpace(record[c.Column])
+	f, err := strconv.ParseFloat(cell, 64)
+	if err != nil {
+		return fmt.Errorf("row %d: %w %q", row, ErrInvalidNumber, cell)
+	}
+	v, err := Convert(f, c.From, c.To)
+	if err != nil {
+		return fmt.Errorf("row %d: %w", row, err)
+	}
+	record[c.Column] = strconv.FormatFloat(v, 'g', -1, 64)
+	return nil
+}
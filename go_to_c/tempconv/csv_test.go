@@ -0,0 +1,53 @@
+package tempconv
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestCSVConverterSkipErrors(t *testing.T) {
+	f, err := os.Open(filepath.Join("testdata", "readings.csv"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	var out strings.Builder
+	c := CSVConverter{Column: 1, From: Fahrenheit, To: Celsius, Header: true, SkipErrors: true}
+	if err := c.Convert(f, &out); err != nil {
+		t.Fatal(err)
+	}
+	want := "time,temp_f,site\n08:00,0,north\n09:00,100,south\n10:00,n/a,east\n11:00\n12:00,-40,west\n"
+	if out.String() != want {
+		t.Errorf("output = %q, want %q", out.String(), want)
+	}
+}
+
+func TestCSVConverterErrorRow(t *testing.T) {
+	f, err := os.Open(filepath.Join("testdata", "readings.csv"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	var out strings.Builder
+	c := CSVConverter{Column: 1, From: Fahrenheit, To: Celsius, Header: true}
+	err = c.Convert(f, &out)
+	if !errors.Is(err, ErrInvalidNumber) || err.Error() != `row 4: tempconv: invalid number "n/a"` {
+		t.Errorf("Convert error = %v, want row 4 ErrInvalidNumber", err)
+	}
+	// Rows before the bad one have been written.
+	if want := "time,temp_f,site\n08:00,0,north\n09:00,100,south\n"; out.String() != want {
+		t.Errorf("output = %q, want %q", out.String(), want)
+	}
+}
+
+func TestConvertCSVMissingColumn(t *testing.T) {
+	err := ConvertCSV(strings.NewReader("32\n"), new(strings.Builder), 1, Fahrenheit, Celsius)
+	if !errors.Is(err, ErrMissingColumn) || !strings.HasPrefix(err.Error(), "row 1: ") {
+		t.Errorf("ConvertCSV error = %v, want row 1 ErrMissingColumn", err)
+	}
+}
@@ -0,0 +1,41 @@
+package tempconv
+
+import (
+	"log"
+	"os"
+)
+
+// DefaultScaleEnv names the environment variable that sets the scale
+// ConvertDefault converts to, using a Scale symbol such as "K" (in any case).
+const DefaultScaleEnv = "TEMPCONV_DEFAULT_SCALE"
+
+// defaultScale is read from DefaultScaleEnv once during package
+// initialization and not changed afterwards.
+var defaultScale = scaleFromEnv()
+
+// scaleFromEnv returns the scale named by DefaultScaleEnv. It falls back to
+// Celsius if the variable is unset or empty, and logs a warning first if it
+// names no supported scale.
+func scaleFromEnv() Scale {
+	v := os.Getenv(DefaultScaleEnv)
+	if v == "" {
+		return Celsius
+	}
+	s, ok := parseScale(v)
+	if !ok {
+		log.Printf("tempconv: ignoring %s=%q: unknown scale, using %s", DefaultScaleEnv, v, Celsius)
+		return Celsius
+	}
+	return s
+}
+
+// DefaultScale returns the scale ConvertDefault converts to: the one named by
+// the TEMPCONV_DEFAULT_SCALE environment variable at startup, or Celsius.
+func DefaultScale() Scale {
+	return defaultScale
+}
+
+// ConvertDefault converts value from one scale to DefaultScale().
+func ConvertDefault(value float64, from Scale) (float64, error) {
+	return Convert(value, from, defaultScale)
+}
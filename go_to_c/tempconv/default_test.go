@@ -0,0 +1,46 @@
+package tempconv
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+)
+
+// TestDefaultScaleHelper is not a real test. TestDefaultScaleEnv runs the test
+// binary again with it selected, since defaultScale is only read from the
+// environment at package initialization.
+func TestDefaultScaleHelper(t *testing.T) {
+	if os.Getenv("TEMPCONV_TEST_HELPER") != "1" {
+		t.Skip("helper process for TestDefaultScaleEnv")
+	}
+	v, err := ConvertDefault(32, Fahrenheit)
+	fmt.Printf("%s %v %v\n", DefaultScale(), v, err)
+}
+
+func TestDefaultScaleEnv(t *testing.T) {
+	tests := []struct {
+		env  string
+		want string
+	}{
+		{"", "C 0 <nil>"},
+		{"K", "K 273.15 <nil>"},
+		{"k", "K 273.15 <nil>"},
+		{"re", "Re 0 <nil>"},
+		{"bogus", "C 0 <nil>"},
+	}
+	for _, tt := range tests {
+		cmd := exec.Command(os.Args[0], "-test.run=^TestDefaultScaleHelper$")
+		cmd.Env = append(os.Environ(), "TEMPCONV_TEST_HELPER=1", DefaultScaleEnv+"="+tt.env)
+		out, err := cmd.Output()
+		if err != nil {
+			t.Fatalf("%s=%q: %v", DefaultScaleEnv, tt.env, err)
+		}
+		// The helper's line comes before the testing package's PASS.
+		got, _, _ := strings.Cut(string(out), "\n")
+		if got != tt.want {
+			t.Errorf("%s=%q: got %q, want %q", DefaultScaleEnv, tt.env, got, tt.want)
+		}
+	}
+}
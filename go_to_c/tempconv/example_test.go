@@ -0,0 +1,17 @@
+package tempconv_test
+
+import (
+	"fmt"
+
+	"github.com/avr1/lsd_ceph/go_to_c/tempconv"
+)
+
+func ExampleFToC() {
+	c, err := tempconv.FToC(212)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(c)
+	// Output: 100
+}
@@ -0,0 +1,101 @@
+package tempconv
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// The formulas below describe, for display only, the arithmetic the C
+// functions perform. Each %s is replaced by an operand.
+var (
+	toCelsiusFormula = map[Scale]string{
+		Fahrenheit: "(%s - 32) × 5/9",
+		Kelvin:     "%s - 273.15",
+		Rankine:    "(%s - 459.67 - 32) × 5/9",
+		Reaumur:    "%s × 5/4",
+		Delisle:    "100 - %s × 2/3",
+		Newton:     "%s / 0.33",
+	}
+	fromCelsiusFormula = map[Scale]string{
+		Fahrenheit: "%s × 9/5 + 32",
+		Kelvin:     "%s + 273.15",
+		Rankine:    "%s × 9/5 + 32 + 459.67",
+		Reaumur:    "%s × 4/5",
+		Delisle:    "(100 - %s) × 3/2",
+		Newton:     "%s × 0.33",
+	}
+	// directFormula covers the pairs in direct that do not go through
+	// Celsius.
+	directFormula = map[[2]Scale]string{
+		{Fahrenheit, Kelvin}:  "(%s + 459.67) × 5/9",
+		{Kelvin, Fahrenheit}:  "%s × 9/5 - 459.67",
+		{Fahrenheit, Rankine}: "%s + 459.67",
+		{Rankine, Fahrenheit}: "%s - 459.67",
+		{Rankine, Kelvin}:     "%s × 5/9",
+		{Kelvin, Rankine}:     "%s × 9/5",
+	}
+)
+
+// ConvertExplain converts like Convert and also describes the arithmetic
+// used, for example "(72 - 32) × 5/9 = 22.22" for 72°F to Celsius.
+// Conversions that go through Celsius show both steps separated by "; ".
+// Numbers in steps are rounded to two decimal places; result is not. The
+// identity conversion is described as "no conversion needed".
+func ConvertExplain(value float64, from, to Scale) (result float64, steps string, err error) {
+	result, err = Convert(value, from, to)
+	if err != nil {
+		return 0, "", err
+	}
+	return result, explain(value, result, from, to), nil
+}
+
+// explain describes how value on from became result on to.
+func explain(value, result float64, from, to Scale) string {
+	if from == to {
+		return "no conversion needed"
+	}
+	if f, ok := directFormula[[2]Scale{from, to}]; ok {
+		return step(f, value, result)
+	}
+
+	toC, okTo := toCelsiusFormula[from]
+	fromC, okFrom := fromCelsiusFormula[to]
+	if (from != Celsius && !okTo) || (to != Celsius && !okFrom) {
+		// A custom scale; its Converter is opaque.
+		return fmt.Sprintf("%s %s → %s with the registered converter = %s", operand(value), from, to, number(result))
+	}
+
+	var parts []string
+	if from != Celsius {
+		src, _ := lookup(from)
+		c := src.ToCelsius(value)
+		parts = append(parts, step(toC, value, c))
+		value = c
+	}
+	if to != Celsius {
+		parts = append(parts, step(fromC, value, result))
+	}
+	return strings.Join(parts, "; ")
+}
+
+// step renders one formula applied to in, giving out.
+func step(formula string, in, out float64) string {
+	return fmt.Sprintf(formula, operand(in)) + " = " + number(out)
+}
+
+// number formats v rounded to two decimal places, without trailing zeros.
+func number(v float64) string {
+	return strconv.FormatFloat(math.Round(v*100)/100, 'f', -1, 64)
+}
+
+// operand is number with negative values parenthesized, so that formulas
+// like "100 - (-40) × 2/3" stay readable.
+func operand(v float64) string {
+	s := number(v)
+	if strings.HasPrefix(s, "-") {
+		return "(" + s + ")"
+	}
+	return s
+}
@@ -0,0 +1,39 @@
+package tempconv
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestConvertExplain(t *testing.T) {
+	tests := []struct {
+		value    float64
+		from, to Scale
+		steps    string
+	}{
+		{72, Fahrenheit, Celsius, "(72 - 32) × 5/9 = 22.22"},
+		{37, Celsius, Celsius, "no conversion needed"},
+		{32, Fahrenheit, Kelvin, "(32 + 459.67) × 5/9 = 273.15"},
+		{-40, Delisle, Fahrenheit, "100 - (-40) × 2/3 = 126.67; 126.67 × 9/5 + 32 = 260"},
+	}
+	for _, tt := range tests {
+		result, steps, err := ConvertExplain(tt.value, tt.from, tt.to)
+		if err != nil {
+			t.Errorf("ConvertExplain(%v, %s, %s): %v", tt.value, tt.from, tt.to, err)
+			continue
+		}
+		if want, _ := Convert(tt.value, tt.from, tt.to); result != want {
+			t.Errorf("ConvertExplain(%v, %s, %s) result = %v, want %v", tt.value, tt.from, tt.to, result, want)
+		}
+		if steps != tt.steps {
+			t.Errorf("ConvertExplain(%v, %s, %s) steps = %q, want %q", tt.value, tt.from, tt.to, steps, tt.steps)
+		}
+	}
+	if r, steps, err := ConvertExplain(-500, Fahrenheit, Celsius); !errors.Is(err, ErrBelowAbsoluteZero) || r != 0 || steps != "" {
+		t.Errorf("ConvertExplain(-500, F, C) = %v, %q, %v, want ErrBelowAbsoluteZero", r, steps, err)
+	}
+	if _, _, err := ConvertExplain(math.NaN(), Fahrenheit, Celsius); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("ConvertExplain(NaN, F, C) error = %v, want ErrInvalidInput", err)
+	}
+}
@@ -0,0 +1,126 @@
+//go:build cgo && !tempconv_clib
+
+/* The build constraint above keeps this file out of tempconv_clib builds,
+ * which link the archive built by the Makefile instead. The C compiler sees
+ * it as an ordinary comment. */
+#include "f_to_c.h"
+
+#if defined(__SSE2__) && !defined(TEMPCONV_NO_SIMD)
+#include <emmintrin.h>
+#endif
+
+double f_to_c(double fahr) {
+   double c = ((fahr - 32) * 5.0)/9.0; 
+   return c; 
+}
+
+float f_to_c_f(float fahr) {
+   float cels = ((fahr - 32.0f) * 5.0f)/9.0f;
+   return cels;
+}
+
+double c_to_f(double cels) {
+   double f = (cels * 9.0)/5.0 + 32;
+   return f;
+}
+
+double c_to_k(double cels) {
+   double k = cels + 273.15;
+   return k;
+}
+
+double k_to_c(double kelv) {
+   double c = kelv - 273.15;
+   return c;
+}
+
+/* Kelvin and Rankine share a zero, so offsetting to Rankine first keeps
+ * absolute zero exact instead of going through Celsius. */
+double f_to_k(double fahr) {
+   double k = ((fahr + 459.67) * 5.0)/9.0;
+   return k;
+}
+
+double k_to_f(double kelv) {
+   double f = (kelv * 9.0)/5.0 - 459.67;
+   return f;
+}
+
+/* Rankine is Fahrenheit shifted so that 0 is absolute zero. */
+double f_to_r(double fahr) {
+   double r = fahr + 459.67;
+   return r;
+}
+
+double r_to_f(double rank) {
+   double f = rank - 459.67;
+   return f;
+}
+
+/* Reaumur puts water's boiling point at 80, i.e. 4/5 of a Celsius degree. */
+double c_to_re(double cels) {
+   double re = (cels * 4.0)/5.0;
+   return re;
+}
+
+double re_to_c(double reau) {
+   double c = (reau * 5.0)/4.0;
+   return c;
+}
+
+/* Delisle runs backwards from water's boiling point (0) to its freezing
+ * point (150), so hotter temperatures have smaller values. */
+double c_to_de(double cels) {
+   double de = ((100 - cels) * 3.0)/2.0;
+   return de;
+}
+
+double de_to_c(double deli) {
+   double c = 100 - (deli * 2.0)/3.0;
+   return c;
+}
+
+/* Newton puts water's boiling point at 33, so a Newton degree is 100/33 of
+ * a Celsius degree. Scaling by 0.33 directly keeps both water's boiling point
+ * and absolute zero exact. */
+double c_to_n(double cels) {
+   double n = cels * 0.33;
+   return n;
+}
+
+double n_to_c(double newt) {
+   double c = newt / 0.33;
+   return c;
+}
+
+/* The batch loop applies the same three operations as f_to_c to every
+ * element, and restrict tells the compiler in and out do not overlap, so it
+ * is free to vectorize. With SSE2 the first part of the array is also done
+ * two values at a time explicitly, so the speedup does not depend on the
+ * optimizer. Packed and scalar SSE2 arithmetic round the same way, so the
+ * results are bit-identical to f_to_c either way. Define TEMPCONV_NO_SIMD to
+ * build the plain loop only. */
+void f_to_c_batch(const double* restrict in, double* restrict out, size_t n) {
+   size_t i = 0;
+#if defined(__SSE2__) && !defined(TEMPCONV_NO_SIMD)
+   const __m128d offset = _mm_set1_pd(32);
+   const __m128d mul = _mm_set1_pd(5.0);
+   const __m128d div = _mm_set1_pd(9.0);
+   for (; i + 2 <= n; i += 2) {
+      __m128d f = _mm_loadu_pd(in + i);
+      __m128d c = _mm_div_pd(_mm_mul_pd(_mm_sub_pd(f, offset), mul), div);
+      _mm_storeu_pd(out + i, c);
+   }
+#endif
+   for (; i < n; i++) {
+      out[i] = ((in[i] - 32) * 5.0)/9.0;
+   }
+}
+
+void f_to_c_inplace(double* vals, size_t n) {
+   size_t i;
+   for (i = 0; i < n; i++) {
+      vals[i] = f_to_c(vals[i]);
+   }
+}
+
@@ -0,0 +1,143 @@
+//go:build cgo
+
+package tempconv
+
+// f_to_c.c is also built as a standalone library by the Makefile here. By
+// default cgo compiles it into this package; with -tags tempconv_clib the
+// archive is linked instead (go generate builds it). The go command does not
+// notice changes to the archive, so rebuild with go build -a after
+// regenerating it.
+//
+// The include path is spelled out with ${SRCDIR} rather than relying on the
+// compiler searching the source directory, so the header is found the same
+// way by gcc, clang and mingw-w64 gcc on Windows. Nothing else here is
+// platform specific: the C code is plain C99 with no system headers beyond
+// the standard library, except for SSE2 intrinsics in f_to_c_batch that are
+// only compiled where the compiler defines __SSE2__.
+
+//go:generate make
+
+// #cgo CFLAGS: -g -Wall -I${SRCDIR}
+// #cgo tempconv_clib LDFLAGS: ${SRCDIR}/build/libtempconv.a
+// #include <stdlib.h>
+// #include "f_to_c.h"
+import "C"
+
+import "unsafe"
+
+// Cost of the cgo boundary, as measured by the benchmarks in bench_test.go
+// (go test -bench=.): on a typical x86-64 machine BenchmarkFToC/cgo takes
+// about 44ns per call against 1.6ns for BenchmarkFToC/go, the same arithmetic
+// in Go. Over a million values BenchmarkLoop/cgo/1000000 takes about 45ms, the
+// pure-Go loop 1.6ms and fToCBatch 9ms, most of which is copying into and out
+// of C memory. The C path is kept because the point of this package is
+// calling shared C code; hot loops that only need the numbers should batch,
+// and rerun the benchmarks before relying on these figures.
+
+func fToC(f float64) float64 {
+	return float64(C.f_to_c(C.double(f)))
+}
+
+func fToC32(f float32) float32 {
+	return float32(C.f_to_c_f(C.float(f)))
+}
+
+func cToF(c float64) float64 {
+	return float64(C.c_to_f(C.double(c)))
+}
+
+func cToK(c float64) float64 {
+	return float64(C.c_to_k(C.double(c)))
+}
+
+func kToC(k float64) float64 {
+	return float64(C.k_to_c(C.double(k)))
+}
+
+func fToK(f float64) float64 {
+	return float64(C.f_to_k(C.double(f)))
+}
+
+func kToF(k float64) float64 {
+	return float64(C.k_to_f(C.double(k)))
+}
+
+func fToR(f float64) float64 {
+	return float64(C.f_to_r(C.double(f)))
+}
+
+func rToF(r float64) float64 {
+	return float64(C.r_to_f(C.double(r)))
+}
+
+func cToRe(c float64) float64 {
+	return float64(C.c_to_re(C.double(c)))
+}
+
+func reToC(re float64) float64 {
+	return float64(C.re_to_c(C.double(re)))
+}
+
+func cToDe(c float64) float64 {
+	return float64(C.c_to_de(C.double(c)))
+}
+
+func deToC(de float64) float64 {
+	return float64(C.de_to_c(C.double(de)))
+}
+
+func cToN(c float64) float64 {
+	return float64(C.c_to_n(C.double(c)))
+}
+
+func nToC(n float64) float64 {
+	return float64(C.n_to_c(C.double(n)))
+}
+
+// fToCBatch copies in to C memory, converts it with one f_to_c_batch call and
+// copies the results to out, which must be at least as long as in.
+//
+// Ownership at the cgo boundary: both C buffers are allocated here with
+// C.malloc and released by the deferred C.free calls before returning, on
+// every path, so nothing allocated in C outlives the call. f_to_c_batch only
+// reads and writes the buffers it is given and keeps no pointer to them. Go
+// memory is never handed to C: in is only read and out is written from Go,
+// and neither is retained.
+func fToCBatch(in, out []float64) {
+	n := len(in)
+	if n == 0 {
+		return
+	}
+
+	size := C.size_t(n) * C.size_t(unsafe.Sizeof(C.double(0)))
+	cin := (*C.double)(C.malloc(size))
+	defer C.free(unsafe.Pointer(cin))
+	cout := (*C.double)(C.malloc(size))
+	defer C.free(unsafe.Pointer(cout))
+
+	cinSlice := unsafe.Slice(cin, n)
+	for i, f := range in {
+		cinSlice[i] = C.double(f)
+	}
+
+	C.f_to_c_batch(cin, cout, C.size_t(n))
+
+	coutSlice := unsafe.Slice(cout, n)
+	for i, c := range coutSlice {
+		out[i] = float64(c)
+	}
+}
+
+// fToCInPlace converts vals with one f_to_c_inplace call.
+//
+// Unlike fToCBatch this hands Go memory straight to C, which the cgo pointer
+// rules allow because a []float64 holds no Go pointers; the runtime keeps it
+// in place for the duration of the call. float64 and C double share a
+// representation, so no copy is needed, and f_to_c_inplace keeps no pointer
+// to vals after returning.
+func fToCInPlace(vals []float64) {
+	if len(vals) == 0 {
+		return
+	}
+	C.f_to_c_inplace((*C.double)(unsafe.Pointer(&vals[0])), C.size_t(len(vals)))
+}
@@ -0,0 +1,39 @@
+#ifndef _TEMP_CONVERTER
+#define _TEMP_CONVERTER
+
+#include <stddef.h>
+
+/* All functions here are reentrant: they keep no static or global state and
+ * may be called concurrently from any number of threads. */
+
+double f_to_c(double fahr);
+double c_to_f(double cels);
+
+/* Single-precision f_to_c for callers that work in float throughout. */
+float f_to_c_f(float fahr);
+
+double c_to_k(double cels);
+double k_to_c(double kelv);
+double f_to_k(double fahr);
+double k_to_f(double kelv);
+
+double f_to_r(double fahr);
+double r_to_f(double rank);
+double c_to_re(double cels);
+double re_to_c(double reau);
+double c_to_de(double cels);
+double de_to_c(double deli);
+double c_to_n(double cels);
+double n_to_c(double newt);
+
+/* Converts n Fahrenheit values from in to Celsius in out. Both buffers are
+ * owned by the caller; nothing is allocated or retained. The buffers must not
+ * overlap; use f_to_c_inplace to convert in place. */
+void f_to_c_batch(const double* in, double* out, size_t n);
+
+/* Converts n Fahrenheit values in vals to Celsius, overwriting them. Kept
+ * separate from f_to_c_batch so that one can promise its buffers do not
+ * alias. */
+void f_to_c_inplace(double* vals, size_t n);
+
+#endif
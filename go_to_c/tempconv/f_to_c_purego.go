@@ -0,0 +1,78 @@
+//go:build !cgo
+
+package tempconv
+
+// The functions below mirror f_to_c.c operation for operation so that cgo
+// and non-cgo builds produce the same results. Keep the two in sync.
+
+func fToC(f float64) float64 {
+	return ((f - 32) * 5.0) / 9.0
+}
+
+func fToC32(f float32) float32 {
+	return ((f - 32) * 5.0) / 9.0
+}
+
+func cToF(c float64) float64 {
+	return (c*9.0)/5.0 + 32
+}
+
+func cToK(c float64) float64 {
+	return c + 273.15
+}
+
+func kToC(k float64) float64 {
+	return k - 273.15
+}
+
+func fToK(f float64) float64 {
+	return ((f + 459.67) * 5.0) / 9.0
+}
+
+func kToF(k float64) float64 {
+	return (k*9.0)/5.0 - 459.67
+}
+
+func fToR(f float64) float64 {
+	return f + 459.67
+}
+
+func rToF(r float64) float64 {
+	return r - 459.67
+}
+
+func cToRe(c float64) float64 {
+	return (c * 4.0) / 5.0
+}
+
+func reToC(re float64) float64 {
+	return (re * 5.0) / 4.0
+}
+
+func cToDe(c float64) float64 {
+	return ((100 - c) * 3.0) / 2.0
+}
+
+func deToC(de float64) float64 {
+	return 100 - (de*2.0)/3.0
+}
+
+func cToN(c float64) float64 {
+	return c * 0.33
+}
+
+func nToC(n float64) float64 {
+	return n / 0.33
+}
+
+func fToCBatch(in, out []float64) {
+	for i, f := range in {
+		out[i] = fToC(f)
+	}
+}
+
+func fToCInPlace(vals []float64) {
+	for i, f := range vals {
+		vals[i] = fToC(f)
+	}
+}
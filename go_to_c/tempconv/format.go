@@ -0,0 +1,59 @@
+package tempconv
+
+import (
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	xnumber "golang.org/x/text/number"
+)
+
+// symbols holds the unit suffix Format appends for each scale. Kelvin is an
+// absolute unit and by convention takes no degree sign.
+var symbols = map[Scale]string{
+	Fahrenheit: "°F",
+	Celsius:    "°C",
+	Kelvin:     " K",
+	Rankine:    "°Ra",
+	Reaumur:    "°Ré",
+	Delisle:    "°De",
+	Newton:     "°N",
+}
+
+// Format renders value with one decimal place followed by the unit of s, for
+// example "37.0°C", "-40.0°F" or "300.0 K". A scale without a known symbol
+// is written after a space using its Scale value. Use FormatPrec for other
+// precisions.
+func Format(value float64, s Scale) string {
+	return FormatPrec(value, s, 1)
+}
+
+// FormatPrec is like Format with prec decimal places, or with the fewest
+// digits that represent value exactly if prec is -1. Rounding is of the
+// float64 value, so Format(310.15, Kelvin) is "310.1 K", since 310.15 is
+// stored as slightly less, while FormatPrec(310.15, Kelvin, 2) and
+// FormatPrec(310.15, Kelvin, -1) are both "310.15 K".
+func FormatPrec(value float64, s Scale, prec int) string {
+	return strconv.FormatFloat(value, 'f', prec, 64) + unitSymbol(s)
+}
+
+// unitSymbol returns the suffix Format writes after a value on scale s.
+func unitSymbol(s Scale) string {
+	if symbol, ok := symbols[s]; ok {
+		return symbol
+	}
+	return " " + string(s)
+}
+
+// FormatLocale is like Format but writes the number the way locale, a BCP 47
+// tag such as "de-DE" or "en-US" (an underscore instead of the hyphen is
+// accepted too), writes decimals, using the CLDR data in golang.org/x/text.
+// Regional variants are honored, so 22.22°C is "22,2°C" for "de-DE" but
+// "22.2°C" for "de-CH" and "en-US". No digit grouping is added, and an empty
+// or unknown locale gets Format's period.
+func FormatLocale(value float64, s Scale, locale string) string {
+	tag, _ := language.Parse(strings.ReplaceAll(locale, "_", "-"))
+	p := message.NewPrinter(tag)
+	return p.Sprint(xnumber.Decimal(value, xnumber.Scale(1), xnumber.NoSeparator())) + unitSymbol(s)
+}
@@ -0,0 +1,68 @@
+package tempconv
+
+import "testing"
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		value float64
+		s     Scale
+		want  string
+	}{
+		{-40, Celsius, "-40.0°C"},
+		{98.6, Fahrenheit, "98.6°F"},
+		{300, Kelvin, "300.0 K"},
+		{491.67, Rankine, "491.7°Ra"},
+		{80, Reaumur, "80.0°Ré"},
+		{150, Delisle, "150.0°De"},
+		{33, Newton, "33.0°N"},
+		{1, Scale("double-celsius"), "1.0 double-celsius"},
+	}
+	for _, tt := range tests {
+		if got := Format(tt.value, tt.s); got != tt.want {
+			t.Errorf("Format(%v, %s) = %q, want %q", tt.value, tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestFormatPrec(t *testing.T) {
+	tests := []struct {
+		value float64
+		prec  int
+		want  string
+	}{
+		{310.15, 1, "310.1 K"},
+		{310.15, 2, "310.15 K"},
+		{310.15, -1, "310.15 K"},
+		{310.15, 0, "310 K"},
+	}
+	for _, tt := range tests {
+		if got := FormatPrec(tt.value, Kelvin, tt.prec); got != tt.want {
+			t.Errorf("FormatPrec(%v, K, %d) = %q, want %q", tt.value, tt.prec, got, tt.want)
+		}
+	}
+}
+
+func TestFormatLocale(t *testing.T) {
+	tests := []struct {
+		locale string
+		want   string
+	}{
+		{"en-US", "22.2°C"},
+		{"de-DE", "22,2°C"},
+		{"de_DE", "22,2°C"},
+		{"de-CH", "22.2°C"},
+		{"es-MX", "22.2°C"},
+		{"fr-FR", "22,2°C"},
+		{"", "22.2°C"},
+		{"not a locale", "22.2°C"},
+	}
+	for _, tt := range tests {
+		if got := FormatLocale(22.22, Celsius, tt.locale); got != tt.want {
+			t.Errorf("FormatLocale(22.22, C, %q) = %q, want %q", tt.locale, got, tt.want)
+		}
+	}
+	// No digit grouping.
+	if got, want := FormatLocale(12345.6, Kelvin, "de-DE"), "12345,6 K"; got != want {
+		t.Errorf("FormatLocale(12345.6, K, de-DE) = %q, want %q", got, want)
+	}
+}
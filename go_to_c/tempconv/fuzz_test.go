@@ -0,0 +1,70 @@
+package tempconv
+
+import (
+	"math"
+	"testing"
+)
+
+// fuzzScales lists every scale with its absolute zero, the lower bound of
+// valid input, or the upper bound for an inverted scale like Delisle.
+var fuzzScales = []struct {
+	scale    Scale
+	zero     float64
+	inverted bool
+}{
+	{Fahrenheit, AbsoluteZeroF, false},
+	{Celsius, AbsoluteZeroC, false},
+	{Kelvin, AbsoluteZeroK, false},
+	{Rankine, AbsoluteZeroR, false},
+	{Reaumur, AbsoluteZeroRe, false},
+	{Delisle, AbsoluteZeroDe, true},
+	{Newton, AbsoluteZeroN, false},
+}
+
+// fuzzMax bounds the inputs FuzzConvert expects to convert, well inside the
+// range where no scaling can overflow.
+const fuzzMax = 1e15
+
+func FuzzConvert(f *testing.F) {
+	// -40 is the F/C fixed point and 1e308 overflows when scaled.
+	for _, v := range []float64{-40, 0, 1e308} {
+		for i := range fuzzScales {
+			f.Add(v, uint8(i), uint8((i+1)%len(fuzzScales)))
+		}
+	}
+	f.Fuzz(func(t *testing.T, value float64, from, to uint8) {
+		src := fuzzScales[int(from)%len(fuzzScales)]
+		dst := fuzzScales[int(to)%len(fuzzScales)]
+		r, err := Convert(value, src.scale, dst.scale)
+		colder := value < src.zero
+		if src.inverted {
+			colder = value > src.zero
+		}
+		if colder || math.Abs(value) > fuzzMax || math.IsNaN(value) {
+			return
+		}
+		if err != nil {
+			t.Fatalf("Convert(%v, %s, %s) failed on a valid input: %v", value, src.scale, dst.scale, err)
+		}
+		if math.IsNaN(r) || math.IsInf(r, 0) {
+			t.Fatalf("Convert(%v, %s, %s) = %v, want a finite result", value, src.scale, dst.scale, r)
+		}
+	})
+}
+
+func FuzzParse(f *testing.F) {
+	for _, s := range []string{"-40F", "0C", "1e308K", " 72 °f ", "", "°", "F", "1e309C", "\xff"} {
+		f.Add(s)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		_, unit, err := Parse(s)
+		if err != nil {
+			return
+		}
+		switch unit {
+		case 'F', 'C', 'K':
+		default:
+			t.Fatalf("Parse(%q) returned unit %q", s, unit)
+		}
+	})
+}
@@ -0,0 +1,64 @@
+package tempconv
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "rewrite testdata golden files")
+
+// goldenInputs are converted from every scale to every other. They are valid
+// on all the built-in scales, including the inverted Delisle.
+var goldenInputs = []float64{-40, 0, 37.5, 98.6, 212}
+
+// TestConvertGolden checks Convert against results recorded in
+// testdata/convert.golden, bit for bit. CI runs it with and without cgo, so
+// the C and pure-Go arithmetic must agree exactly. Regenerate the file with
+// go test -run TestConvertGolden -update after an intended change.
+func TestConvertGolden(t *testing.T) {
+	builtin := []Scale{Fahrenheit, Celsius, Kelvin, Rankine, Reaumur, Delisle, Newton}
+	var got strings.Builder
+	for _, from := range builtin {
+		for _, to := range builtin {
+			for _, v := range goldenInputs {
+				r, err := Convert(v, from, to)
+				if err != nil {
+					fmt.Fprintf(&got, "%s %s %v error\n", from, to, v)
+					continue
+				}
+				fmt.Fprintf(&got, "%s %s %v %s\n", from, to, v, strconv.FormatFloat(r, 'g', -1, 64))
+			}
+		}
+	}
+
+	path := filepath.Join("testdata", "convert.golden")
+	if *update {
+		if err := os.WriteFile(path, []byte(got.String()), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gotLines := bufio.NewScanner(strings.NewReader(got.String()))
+	wantLines := bufio.NewScanner(strings.NewReader(string(want)))
+	for line := 1; wantLines.Scan(); line++ {
+		if !gotLines.Scan() {
+			t.Fatalf("line %d: missing, want %q", line, wantLines.Text())
+		}
+		if gotLines.Text() != wantLines.Text() {
+			t.Errorf("line %d: got %q, want %q", line, gotLines.Text(), wantLines.Text())
+		}
+	}
+	if gotLines.Scan() {
+		t.Errorf("extra output %q", gotLines.Text())
+	}
+}
@@ -0,0 +1,28 @@
+package tempconv
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// Hook is called after a conversion with its scales, input, result, error
+// and how long the conversion took.
+type Hook func(from, to Scale, value, result float64, elapsed time.Duration, err error)
+
+// hookHolder wraps a Hook, since atomic.Value cannot store a nil func.
+type hookHolder struct{ hook Hook }
+
+var hook atomic.Value // hookHolder
+
+// SetHook installs h to be called after every Convert, including the calls
+// made by ConvertTo, Temperature.To and the other helpers built on Convert.
+// Passing nil removes the hook. h may be called from many goroutines at once.
+// While no hook is set Convert does no timing.
+func SetHook(h Hook) {
+	hook.Store(hookHolder{h})
+}
+
+func loadHook() Hook {
+	h, _ := hook.Load().(hookHolder)
+	return h.hook
+}
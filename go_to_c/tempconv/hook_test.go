@@ -0,0 +1,46 @@
+package tempconv
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+type hookCall struct {
+	from, to      Scale
+	value, result float64
+	err           error
+}
+
+func TestSetHook(t *testing.T) {
+	var calls []hookCall
+	SetHook(func(from, to Scale, value, result float64, elapsed time.Duration, err error) {
+		if elapsed < 0 {
+			t.Errorf("hook elapsed = %v, want >= 0", elapsed)
+		}
+		calls = append(calls, hookCall{from, to, value, result, err})
+	})
+	t.Cleanup(func() { SetHook(nil) })
+
+	Convert(212, Fahrenheit, Celsius)
+	Convert(-500, Fahrenheit, Kelvin)
+	want := []hookCall{
+		{Fahrenheit, Celsius, 212, 100, nil},
+		{Fahrenheit, Kelvin, -500, 0, ErrBelowAbsoluteZero},
+	}
+	if len(calls) != len(want) {
+		t.Fatalf("hook called %d times, want %d", len(calls), len(want))
+	}
+	for i, got := range calls {
+		w := want[i]
+		if got.from != w.from || got.to != w.to || got.value != w.value || got.result != w.result || !errors.Is(got.err, w.err) {
+			t.Errorf("call %d = %+v, want %+v", i, got, w)
+		}
+	}
+
+	SetHook(nil)
+	Convert(32, Fahrenheit, Celsius)
+	if len(calls) != len(want) {
+		t.Errorf("hook called after SetHook(nil)")
+	}
+}
@@ -0,0 +1,71 @@
+package tempconv
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// ConvertHandler serves temperature conversions over HTTP. A request like
+//
+//	GET /convert?value=72&from=F&to=C
+//
+// is answered with the JSON object
+//
+//	{"value":22.22222222222222,"from":"F","to":"C"}
+//
+// Scales are matched case-insensitively. Missing or invalid parameters get a
+// 400 Bad Request, and values below absolute zero or too large to convert a
+// 422 Unprocessable Entity, each with a plain-text message.
+type ConvertHandler struct{}
+
+type convertResponse struct {
+	Value float64 `json:"value"`
+	From  Scale   `json:"from"`
+	To    Scale   `json:"to"`
+}
+
+func (ConvertHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	q := r.URL.Query()
+	raw := q.Get("value")
+	if raw == "" {
+		http.Error(w, "missing value parameter", http.StatusBadRequest)
+		return
+	}
+	value, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("invalid value %q", raw), http.StatusBadRequest)
+		return
+	}
+	from, ok := parseScale(q.Get("from"))
+	if !ok {
+		http.Error(w, fmt.Sprintf("invalid from scale %q", q.Get("from")), http.StatusBadRequest)
+		return
+	}
+	to, ok := parseScale(q.Get("to"))
+	if !ok {
+		http.Error(w, fmt.Sprintf("invalid to scale %q", q.Get("to")), http.StatusBadRequest)
+		return
+	}
+
+	result, err := Convert(value, from, to)
+	switch {
+	case errors.Is(err, ErrBelowAbsoluteZero), errors.Is(err, ErrOverflow):
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	case err != nil:
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(convertResponse{Value: result, From: from, To: to})
+}
@@ -0,0 +1,35 @@
+package tempconv
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestConvertHandler(t *testing.T) {
+	tests := []struct {
+		method, target string
+		code           int
+		body           string
+	}{
+		{"GET", "/convert?value=212&from=F&to=C", http.StatusOK, `{"value":100,"from":"F","to":"C"}` + "\n"},
+		{"GET", "/convert?value=0&from=c&to=k", http.StatusOK, `{"value":273.15,"from":"C","to":"K"}` + "\n"},
+		{"GET", "/convert?from=F&to=C", http.StatusBadRequest, "missing value parameter\n"},
+		{"GET", "/convert?value=abc&from=F&to=C", http.StatusBadRequest, "invalid value \"abc\"\n"},
+		{"GET", "/convert?value=1&to=C", http.StatusBadRequest, "invalid from scale \"\"\n"},
+		{"GET", "/convert?value=1&from=F&to=X", http.StatusBadRequest, "invalid to scale \"X\"\n"},
+		{"GET", "/convert?value=-500&from=F&to=C", http.StatusUnprocessableEntity, ErrBelowAbsoluteZero.Error() + "\n"},
+		{"POST", "/convert?value=1&from=F&to=C", http.StatusMethodNotAllowed, "method not allowed\n"},
+	}
+	for _, tt := range tests {
+		rec := httptest.NewRecorder()
+		ConvertHandler{}.ServeHTTP(rec, httptest.NewRequest(tt.method, tt.target, nil))
+		if rec.Code != tt.code || rec.Body.String() != tt.body {
+			t.Errorf("%s %s = %d %q, want %d %q", tt.method, tt.target, rec.Code, rec.Body.String(), tt.code, tt.body)
+		}
+		if tt.code == http.StatusOK && !strings.HasPrefix(rec.Header().Get("Content-Type"), "application/json") {
+			t.Errorf("%s %s Content-Type = %q, want application/json", tt.method, tt.target, rec.Header().Get("Content-Type"))
+		}
+	}
+}
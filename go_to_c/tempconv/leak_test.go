@@ -0,0 +1,59 @@
+package tempconv
+
+import (
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// rss returns the resident set size of the process in bytes, read from
+// /proc/self/statm, or false where that is not available.
+func rss() (uint64, bool) {
+	b, err := os.ReadFile("/proc/self/statm")
+	if err != nil {
+		return 0, false
+	}
+	fields := strings.Fields(string(b))
+	if len(fields) < 2 {
+		return 0, false
+	}
+	pages, err := strconv.ParseUint(fields[1], 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return pages * uint64(os.Getpagesize()), true
+}
+
+// TestFToCBatchNoLeak runs FToCBatch many times and checks the resident set
+// stays bounded. A leak of the C buffers would not show in the Go heap
+// statistics, so it measures the whole process instead.
+func TestFToCBatchNoLeak(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping in short mode")
+	}
+	const (
+		iterations = 5000
+		batch      = 10000
+		limit      = 64 << 20 // far below the iterations*batch*16 bytes a leak would cost
+	)
+	in := make([]float64, batch)
+	for i := range in {
+		in[i] = float64(i)
+	}
+	FToCBatch(in)
+	runtime.GC()
+	before, ok := rss()
+	if !ok {
+		t.Skip("resident set size not available on this platform")
+	}
+	for range iterations {
+		sink = FToCBatch(in)[batch-1]
+	}
+	runtime.GC()
+	after, _ := rss()
+	if after > before && after-before > limit {
+		t.Errorf("resident set grew by %d bytes over %d calls, want at most %d", after-before, iterations, limit)
+	}
+}
@@ -0,0 +1,38 @@
+package tempconv
+
+import (
+	"fmt"
+	"math"
+)
+
+// Measurement is a temperature reading with an uncertainty, such as
+// 72±0.5°F. Uncertainty is in degrees of Scale.
+type Measurement struct {
+	Value       float64
+	Uncertainty float64
+	Scale       Scale
+}
+
+// Convert returns m converted to scale s. The value is converted as by
+// Convert; the uncertainty is a temperature difference, so it is only scaled
+// by the ratio of the two scales' degree sizes (5/9 from Fahrenheit to
+// Celsius, 1 from Celsius to Kelvin) and the scales' offsets do not affect it.
+// The degree size of a custom scale is derived from its Converter, which is
+// assumed to be linear.
+func (m Measurement) Convert(s Scale) (Measurement, error) {
+	v, err := Convert(m.Value, m.Scale, s)
+	if err != nil {
+		return Measurement{}, err
+	}
+	if math.IsNaN(m.Uncertainty) || math.IsInf(m.Uncertainty, 0) {
+		return Measurement{}, fmt.Errorf("uncertainty: %w", ErrInvalidInput)
+	}
+	src, _ := lookup(m.Scale)
+	dst, _ := lookup(s)
+	slope := degree(src) / degree(dst)
+	return Measurement{
+		Value:       v,
+		Uncertainty: math.Abs(m.Uncertainty * slope),
+		Scale:       s,
+	}, nil
+}
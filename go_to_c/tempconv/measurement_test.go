@@ -0,0 +1,36 @@
+package tempconv
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestMeasurementConvert(t *testing.T) {
+	const epsilon = 1e-9
+	tests := []struct {
+		in   Measurement
+		to   Scale
+		want Measurement
+	}{
+		{Measurement{72, 0.9, Fahrenheit}, Celsius, Measurement{22.22222222222222, 0.5, Celsius}},
+		{Measurement{20, 0.5, Celsius}, Kelvin, Measurement{293.15, 0.5, Kelvin}},
+		// Delisle runs backwards, but the uncertainty stays positive.
+		{Measurement{100, 1.5, Celsius}, Delisle, Measurement{0, 2.25, Delisle}},
+	}
+	for _, tt := range tests {
+		got, err := tt.in.Convert(tt.to)
+		if err != nil || got.Scale != tt.want.Scale ||
+			math.Abs(got.Value-tt.want.Value) > epsilon ||
+			math.Abs(got.Uncertainty-tt.want.Uncertainty) > epsilon {
+			t.Errorf("%+v.Convert(%s) = %+v, %v, want %+v", tt.in, tt.to, got, err, tt.want)
+		}
+	}
+}
+
+func TestMeasurementConvertInvalidUncertainty(t *testing.T) {
+	m := Measurement{72, math.NaN(), Fahrenheit}
+	if _, err := m.Convert(Celsius); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("%+v.Convert(C) error = %v, want ErrInvalidInput", m, err)
+	}
+}
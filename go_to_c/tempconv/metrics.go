@@ -0,0 +1,82 @@
+package tempconv
+
+import (
+	"errors"
+	"expvar"
+	"sync"
+	"sync/atomic"
+)
+
+// Pair is a conversion from one scale to another. It renders as "F:C",
+// which is also how it appears as a key in the expvar output.
+type Pair struct {
+	From, To Scale
+}
+
+func (p Pair) String() string {
+	return string(p.From) + ":" + string(p.To)
+}
+
+// MarshalText implements encoding.TextMarshaler, so a map keyed by Pair
+// encodes as a JSON object.
+func (p Pair) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+// Metrics is a snapshot of the counters kept by Convert, which also cover
+// everything built on it such as ConvertHandler, ConvertTo and
+// Temperature.To. The direct functions like FToC are not counted.
+type Metrics struct {
+	// Conversions counts every call to Convert, successful or not.
+	Conversions uint64 `json:"conversions"`
+	// Errors counts the calls that returned an error.
+	Errors uint64 `json:"errors"`
+	// Pairs counts calls by scale pair. Calls naming an unknown scale are
+	// left out so that arbitrary input cannot grow the map.
+	Pairs map[Pair]uint64 `json:"pairs"`
+}
+
+var (
+	conversions      atomic.Uint64
+	conversionErrors atomic.Uint64
+	pairCounts       sync.Map // Pair -> *atomic.Uint64
+)
+
+// The counters are published as the expvar "tempconv", served on
+// /debug/vars by expvar.Handler and, for programs using it,
+// http.DefaultServeMux.
+func init() {
+	expvar.Publish("tempconv", expvar.Func(func() any { return ReadMetrics() }))
+}
+
+func record(from, to Scale, err error) {
+	conversions.Add(1)
+	if err != nil {
+		conversionErrors.Add(1)
+		if errors.Is(err, ErrUnknownUnit) {
+			return
+		}
+	}
+	p := Pair{from, to}
+	n, ok := pairCounts.Load(p)
+	if !ok {
+		n, _ = pairCounts.LoadOrStore(p, new(atomic.Uint64))
+	}
+	n.(*atomic.Uint64).Add(1)
+}
+
+// ReadMetrics returns the current counter values. Each counter is read
+// atomically, but conversions running meanwhile may be counted in one field
+// and not yet in another.
+func ReadMetrics() Metrics {
+	m := Metrics{
+		Conversions: conversions.Load(),
+		Errors:      conversionErrors.Load(),
+		Pairs:       make(map[Pair]uint64),
+	}
+	pairCounts.Range(func(k, v any) bool {
+		m.Pairs[k.(Pair)] = v.(*atomic.Uint64).Load()
+		return true
+	})
+	return m
+}
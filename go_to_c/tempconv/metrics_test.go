@@ -0,0 +1,45 @@
+package tempconv
+
+import (
+	"encoding/json"
+	"expvar"
+	"testing"
+)
+
+func TestReadMetrics(t *testing.T) {
+	before := ReadMetrics()
+	pair := Pair{Newton, Delisle}
+
+	Convert(33, Newton, Delisle)
+	Convert(0, Newton, Delisle)
+	Convert(-100, Newton, Delisle) // below absolute zero
+	Convert(1, "X", Celsius)       // unknown scale, not counted by pair
+
+	after := ReadMetrics()
+	if got := after.Conversions - before.Conversions; got != 4 {
+		t.Errorf("Conversions grew by %d, want 4", got)
+	}
+	if got := after.Errors - before.Errors; got != 2 {
+		t.Errorf("Errors grew by %d, want 2", got)
+	}
+	if got := after.Pairs[pair] - before.Pairs[pair]; got != 3 {
+		t.Errorf("Pairs[%s] grew by %d, want 3", pair, got)
+	}
+	if n, ok := after.Pairs[Pair{"X", Celsius}]; ok {
+		t.Errorf("Pairs[X:C] = %d, want no entry", n)
+	}
+}
+
+func TestMetricsExpvar(t *testing.T) {
+	Convert(33, Newton, Delisle)
+	var m struct {
+		Conversions uint64            `json:"conversions"`
+		Pairs       map[string]uint64 `json:"pairs"`
+	}
+	if err := json.Unmarshal([]byte(expvar.Get("tempconv").String()), &m); err != nil {
+		t.Fatal(err)
+	}
+	if m.Conversions == 0 || m.Pairs["N:De"] == 0 {
+		t.Errorf("expvar tempconv = %+v, want N:De counted", m)
+	}
+}
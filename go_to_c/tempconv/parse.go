@@ -0,0 +1,64 @@
+package tempconv
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+var (
+	// ErrMissingUnit is returned by Parse when the string has no trailing
+	// unit letter.
+	ErrMissingUnit = errors.New("tempconv: missing temperature unit")
+
+	// ErrUnknownUnit is returned when a unit or Scale is not one this
+	// package supports.
+	ErrUnknownUnit = errors.New("tempconv: unknown temperature unit")
+
+	// ErrInvalidNumber is returned when the numeric part of an input is not
+	// a valid number.
+	ErrInvalidNumber = errors.New("tempconv: invalid number")
+)
+
+// Parse reads a temperature such as "72F", "22.5c" or "  -40 f " and returns
+// its numeric value and unit. Surrounding whitespace, whitespace between the
+// number and the unit, and a degree sign before the unit are allowed. The
+// unit is matched case-insensitively and always returned upper case: 'F',
+// 'C' or 'K'. Errors wrap ErrMissingUnit, ErrUnknownUnit or ErrInvalidNumber
+// and name the input.
+func Parse(s string) (value float64, unit rune, err error) {
+	t := strings.TrimSpace(s)
+	last, size := utf8.DecodeLastRuneInString(t)
+	if t == "" || unicode.IsDigit(last) || last == '.' {
+		return 0, 0, fmt.Errorf("parse %q: %w", s, ErrMissingUnit)
+	}
+	unit = unicode.ToUpper(last)
+	switch unit {
+	case 'F', 'C', 'K':
+	default:
+		return 0, 0, fmt.Errorf("parse %q: %w %q", s, ErrUnknownUnit, last)
+	}
+
+	num := strings.TrimSpace(t[:len(t)-size])
+	num = strings.TrimSpace(strings.TrimSuffix(num, "°"))
+	value, err = strconv.ParseFloat(num, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("parse %q: %w %q", s, ErrInvalidNumber, num)
+	}
+	return value, unit, nil
+}
+
+// ConvertTo parses s with Parse and converts it to the target unit, given as
+// the Scale's symbol in either case (for example 'c' or 'K'). See Convert for
+// the errors it can return.
+func ConvertTo(s string, target rune) (float64, error) {
+	value, from, err := Parse(s)
+	if err != nil {
+		return 0, err
+	}
+	to := Scale(string(unicode.ToUpper(target)))
+	return Convert(value, Scale(string(from)), to)
+}
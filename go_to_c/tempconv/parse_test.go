@@ -0,0 +1,60 @@
+package tempconv
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	for _, tt := range []struct {
+		in    string
+		value float64
+		unit  rune
+		err   error
+	}{
+		{"72F", 72, 'F', nil},
+		{"22.5c", 22.5, 'C', nil},
+		{"  -40 f ", -40, 'F', nil},
+		{"300 °K", 300, 'K', nil},
+		{"72", 0, 0, ErrMissingUnit},
+		{"  ", 0, 0, ErrMissingUnit},
+		{"72X", 0, 0, ErrUnknownUnit},
+		{"abcF", 0, 0, ErrInvalidNumber},
+	} {
+		value, unit, err := Parse(tt.in)
+		if !errors.Is(err, tt.err) {
+			t.Errorf("Parse(%q) error = %v, want %v", tt.in, err, tt.err)
+			continue
+		}
+		if value != tt.value || unit != tt.unit {
+			t.Errorf("Parse(%q) = %v, %q, want %v, %q", tt.in, value, unit, tt.value, tt.unit)
+		}
+	}
+}
+
+func TestConvertTo(t *testing.T) {
+	const epsilon = 1e-9
+	for _, tt := range []struct {
+		in     string
+		target rune
+		want   float64
+		err    error
+	}{
+		{"  -40 f ", 'c', -40, nil},
+		{"212F", 'C', 100, nil},
+		{"0c", 'k', 273.15, nil},
+		{"-40", 'C', 0, ErrMissingUnit},
+		{"-40F", 'X', 0, ErrUnknownUnit},
+		{"-40Q", 'C', 0, ErrUnknownUnit},
+	} {
+		got, err := ConvertTo(tt.in, tt.target)
+		if !errors.Is(err, tt.err) {
+			t.Errorf("ConvertTo(%q, %q) error = %v, want %v", tt.in, tt.target, err, tt.err)
+			continue
+		}
+		if math.Abs(got-tt.want) > epsilon {
+			t.Errorf("ConvertTo(%q, %q) = %v, want %v", tt.in, tt.target, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,166 @@
+package tempconv
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+// TestFixedPoints pins the values the package documents as exact, so a
+// change to the C arithmetic that loses one of them fails here.
+func TestFixedPoints(t *testing.T) {
+	tests := []struct {
+		name string
+		from Scale
+		in   float64
+		to   Scale
+		want float64
+	}{
+		// Fahrenheit and Celsius agree at -40.
+		{"-40F", Fahrenheit, -40, Celsius, -40},
+		{"-40C", Celsius, -40, Fahrenheit, -40},
+		{"freezing", Fahrenheit, 32, Celsius, 0},
+		{"boiling", Fahrenheit, 212, Celsius, 100},
+		// Fahrenheit and Kelvin agree at 574.5875; only F->K is exact.
+		{"F=K", Fahrenheit, 574.5875, Kelvin, 574.5875},
+		// Celsius and Kelvin never agree; they differ by exactly 273.15.
+		{"0C", Celsius, 0, Kelvin, 273.15},
+		{"273.15K", Kelvin, 273.15, Celsius, 0},
+		{"zero C->K", Celsius, AbsoluteZeroC, Kelvin, 0},
+		{"zero K->C", Kelvin, 0, Celsius, AbsoluteZeroC},
+		{"zero F->C", Fahrenheit, AbsoluteZeroF, Celsius, AbsoluteZeroC},
+		{"zero F->K", Fahrenheit, AbsoluteZeroF, Kelvin, 0},
+		{"zero K->F", Kelvin, 0, Fahrenheit, AbsoluteZeroF},
+		{"zero F->R", Fahrenheit, AbsoluteZeroF, Rankine, 0},
+		{"zero R->C", Rankine, 0, Celsius, AbsoluteZeroC},
+	}
+	for _, tt := range tests {
+		got, err := Convert(tt.in, tt.from, tt.to)
+		if err != nil || got != tt.want {
+			t.Errorf("%s: Convert(%v, %s, %s) = %v, %v, want exactly %v",
+				tt.name, tt.in, tt.from, tt.to, got, err, tt.want)
+		}
+	}
+	if got, _ := FToC(-40); got != -40 {
+		t.Errorf("FToC(-40) = %v, want exactly -40", got)
+	}
+	if got, _ := CToF(-40); got != -40 {
+		t.Errorf("CToF(-40) = %v, want exactly -40", got)
+	}
+}
+
+// TestDocumentedTolerance checks the conversions the package documents as
+// inexact stay within their stated error.
+func TestDocumentedTolerance(t *testing.T) {
+	tests := []struct {
+		from Scale
+		in   float64
+		to   Scale
+		want float64
+	}{
+		{Kelvin, 574.5875, Fahrenheit, 574.5875},
+		{Celsius, AbsoluteZeroC, Fahrenheit, AbsoluteZeroF},
+		{Celsius, AbsoluteZeroC, Rankine, 0},
+	}
+	for _, tt := range tests {
+		got, err := Convert(tt.in, tt.from, tt.to)
+		if err != nil || math.Abs(got-tt.want) > 5e-12 {
+			t.Errorf("Convert(%v, %s, %s) = %v, %v, want %v within 5e-12",
+				tt.in, tt.from, tt.to, got, err, tt.want)
+		}
+	}
+}
+
+// TestAbsoluteZeroConverts checks that absolute zero on each built-in scale
+// is accepted and converts to absolute zero on every scale. Réaumur's
+// and Delisle's have no exact float64 and used to come out a little below
+// 0 K, which was reported as ErrBelowAbsoluteZero.
+func TestAbsoluteZeroConverts(t *testing.T) {
+	zeros := map[Scale]float64{
+		Fahrenheit: AbsoluteZeroF,
+		Celsius:    AbsoluteZeroC,
+		Kelvin:     AbsoluteZeroK,
+		Rankine:    AbsoluteZeroR,
+		Reaumur:    AbsoluteZeroRe,
+		Delisle:    AbsoluteZeroDe,
+		Newton:     AbsoluteZeroN,
+	}
+	const epsilon = 1e-9
+	for from, v := range zeros {
+		for to, want := range zeros {
+			got, err := Convert(v, from, to)
+			if err != nil || math.Abs(got-want) > epsilon {
+				t.Errorf("Convert(%v, %s, %s) = %v, %v, want %v", v, from, to, got, err, want)
+			}
+		}
+		all, err := ConvertAll(v, from)
+		if err != nil {
+			t.Errorf("ConvertAll(%v, %s): %v", v, from, err)
+			continue
+		}
+		for to, want := range zeros {
+			if math.Abs(all[to]-want) > epsilon {
+				t.Errorf("ConvertAll(%v, %s)[%s] = %v, want %v", v, from, to, all[to], want)
+			}
+		}
+	}
+}
+
+// TestReaumurDelisleAbsoluteZero checks the literal absolute zeros of the two
+// scales where the nearest float64 is just past absolute zero.
+func TestReaumurDelisleAbsoluteZero(t *testing.T) {
+	const epsilon = 1e-9
+	if got, err := ReToC(-218.52); err != nil || math.Abs(got-AbsoluteZeroC) > epsilon {
+		t.Errorf("ReToC(-218.52) = %v, %v, want %v", got, err, AbsoluteZeroC)
+	}
+	if got, err := DeToC(559.725); err != nil || math.Abs(got-AbsoluteZeroC) > epsilon {
+		t.Errorf("DeToC(559.725) = %v, %v, want %v", got, err, AbsoluteZeroC)
+	}
+	if got, err := Convert(-218.52, Reaumur, Kelvin); err != nil || got != 0 {
+		t.Errorf("Convert(-218.52, Re, K) = %v, %v, want 0", got, err)
+	}
+	if got, err := Convert(559.725, Delisle, Celsius); err != nil || math.Abs(got-AbsoluteZeroC) > epsilon {
+		t.Errorf("Convert(559.725, De, C) = %v, %v, want %v", got, err, AbsoluteZeroC)
+	}
+	if got := ConvertClamp(-218.6, Reaumur, Kelvin); got != 0 {
+		t.Errorf("ConvertClamp(-218.6, Re, K) = %v, want 0", got)
+	}
+
+	// Anything further below is still rejected.
+	if _, err := Convert(-218.53, Reaumur, Kelvin); !errors.Is(err, ErrBelowAbsoluteZero) {
+		t.Errorf("Convert(-218.53, Re, K) error = %v, want ErrBelowAbsoluteZero", err)
+	}
+	if _, err := Convert(559.73, Delisle, Kelvin); !errors.Is(err, ErrBelowAbsoluteZero) {
+		t.Errorf("Convert(559.73, De, K) error = %v, want ErrBelowAbsoluteZero", err)
+	}
+}
+
+// TestFToC32Tolerance checks FToC32 against FToC over the ranges its doc
+// comment gives error bounds for, and that the reference points are exact.
+// The bounds include rounding the input to float32, so each float64 input is
+// converted both ways.
+func TestFToC32Tolerance(t *testing.T) {
+	for _, r := range []struct{ max, tolerance float64 }{
+		{1000, 1e-4},
+		{10000, 1e-3},
+	} {
+		for i := 0; ; i++ {
+			f := AbsoluteZeroF + float64(i)/100
+			if f > r.max {
+				break
+			}
+			want, err := FToC(f)
+			if err != nil {
+				t.Fatalf("FToC(%v): %v", f, err)
+			}
+			if got := FToC32(float32(f)); math.Abs(float64(got)-want) > r.tolerance {
+				t.Errorf("FToC32(%v) = %v, want %v within %v", f, got, want, r.tolerance)
+			}
+		}
+	}
+	for _, tt := range []struct{ f, want float32 }{{-40, -40}, {32, 0}, {212, 100}} {
+		if got := FToC32(tt.f); got != tt.want {
+			t.Errorf("FToC32(%v) = %v, want %v", tt.f, got, tt.want)
+		}
+	}
+}
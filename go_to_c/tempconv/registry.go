@@ -0,0 +1,148 @@
+package tempconv
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Converter converts between one temperature scale and Celsius. Custom
+// scales implement it and are added with Register. Both methods must be safe
+// for concurrent use.
+type Converter interface {
+	ToCelsius(v float64) float64
+	FromCelsius(c float64) float64
+}
+
+// builtinScale is the Converter for the scales this package defines. Unlike
+// custom converters it knows its exact absolute zero and degree size.
+type builtinScale struct {
+	absoluteZero float64
+	// degree is the size of one degree of the scale in Celsius degrees, the
+	// slope of toCelsius. It is negative for scales that run backwards, whose
+	// absoluteZero is then an upper rather than a lower bound.
+	degree      float64
+	toCelsius   func(float64) float64
+	fromCelsius func(float64) float64
+}
+
+func (b builtinScale) ToCelsius(v float64) float64   { return b.toCelsius(v) }
+func (b builtinScale) FromCelsius(c float64) float64 { return b.fromCelsius(c) }
+
+var (
+	registryMu sync.RWMutex
+	// registry maps every known Scale to its Converter. It starts out with
+	// the built-in scales; Register adds to it.
+	registry = map[Scale]Converter{
+		Fahrenheit: builtinScale{AbsoluteZeroF, 5.0 / 9.0, fToC, cToF},
+		Celsius:    builtinScale{AbsoluteZeroC, 1, func(c float64) float64 { return c }, func(c float64) float64 { return c }},
+		Kelvin:     builtinScale{AbsoluteZeroK, 1, kToC, cToK},
+		Rankine: builtinScale{
+			AbsoluteZeroR,
+			5.0 / 9.0,
+			func(r float64) float64 { return fToC(rToF(r)) },
+			func(c float64) float64 { return fToR(cToF(c)) },
+		},
+		Reaumur: builtinScale{AbsoluteZeroRe, 5.0 / 4.0, reToC, cToRe},
+		Delisle: builtinScale{AbsoluteZeroDe, -2.0 / 3.0, deToC, cToDe},
+		Newton:  builtinScale{AbsoluteZeroN, 100.0 / 33.0, nToC, cToN},
+	}
+)
+
+// Register makes a custom scale available under name, which can then be used
+// as a Scale anywhere in this package, for example
+// Convert(v, Scale(name), Celsius). Like database/sql.Register, it panics if c
+// is nil, name is empty, or name matches an existing scale ignoring case.
+// Register is meant to be called during initialization but is safe to call
+// at any time.
+func Register(name string, c Converter) {
+	if c == nil {
+		panic("tempconv: Register converter is nil")
+	}
+	if name == "" {
+		panic("tempconv: Register name is empty")
+	}
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	for s := range registry {
+		if strings.EqualFold(string(s), name) {
+			panic(fmt.Sprintf("tempconv: Register called twice for scale %q", name))
+		}
+	}
+	registry[Scale(name)] = c
+}
+
+// Scales returns every known scale, built-in and registered, sorted.
+func Scales() []Scale {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	all := make([]Scale, 0, len(registry))
+	for s := range registry {
+		all = append(all, s)
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i] < all[j] })
+	return all
+}
+
+// lookup returns the Converter registered for s.
+func lookup(s Scale) (Converter, error) {
+	registryMu.RLock()
+	c, ok := registry[s]
+	registryMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("%w %q", ErrUnknownUnit, string(s))
+	}
+	return c, nil
+}
+
+// parseScale returns the known Scale whose name matches s, ignoring case.
+func parseScale(s string) (Scale, bool) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	for scale := range registry {
+		if strings.EqualFold(string(scale), s) {
+			return scale, true
+		}
+	}
+	return "", false
+}
+
+// checkInput validates value as an input on the scale c converts. Built-in
+// scales are checked against their own absolute zero; custom ones by whether
+// value is colder than absolute zero once converted to Celsius.
+func checkInput(value float64, c Converter) error {
+	if b, ok := c.(builtinScale); ok {
+		if b.degree < 0 {
+			// Negating turns the inverted bound into the usual lower one.
+			return check(-value, -b.absoluteZero)
+		}
+		return check(value, b.absoluteZero)
+	}
+	if err := check(value, math.Inf(-1)); err != nil {
+		return err
+	}
+	if c.ToCelsius(value) < AbsoluteZeroC {
+		return ErrBelowAbsoluteZero
+	}
+	return nil
+}
+
+// absoluteZero returns absolute zero on c's scale. Custom converters are
+// asked for it through FromCelsius, so it may be off by rounding.
+func absoluteZero(c Converter) float64 {
+	if b, ok := c.(builtinScale); ok {
+		return b.absoluteZero
+	}
+	return c.FromCelsius(AbsoluteZeroC)
+}
+
+// degree returns the size of one degree of c's scale in Celsius degrees.
+// Custom converters are assumed linear.
+func degree(c Converter) float64 {
+	if b, ok := c.(builtinScale); ok {
+		return b.degree
+	}
+	return c.ToCelsius(1) - c.ToCelsius(0)
+}
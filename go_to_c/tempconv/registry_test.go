@@ -0,0 +1,71 @@
+package tempconv
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+// doubleCelsius is a custom scale whose degrees are half a Celsius degree.
+type doubleCelsius struct{}
+
+func (doubleCelsius) ToCelsius(v float64) float64   { return v / 2 }
+func (doubleCelsius) FromCelsius(c float64) float64 { return c * 2 }
+
+// register adds c under name for the rest of the test only.
+func register(t *testing.T, name string, c Converter) {
+	Register(name, c)
+	t.Cleanup(func() {
+		registryMu.Lock()
+		delete(registry, Scale(name))
+		registryMu.Unlock()
+	})
+}
+
+func TestRegister(t *testing.T) {
+	register(t, "double-celsius", doubleCelsius{})
+	const epsilon = 1e-9
+	dc := Scale("double-celsius")
+	tests := []struct {
+		value    float64
+		from, to Scale
+		want     float64
+	}{
+		{200, dc, Celsius, 100},
+		{100, Celsius, dc, 200},
+		{212, Fahrenheit, dc, 200},
+		{0, Kelvin, dc, -546.3},
+	}
+	for _, tt := range tests {
+		got, err := Convert(tt.value, tt.from, tt.to)
+		if err != nil || math.Abs(got-tt.want) > epsilon {
+			t.Errorf("Convert(%v, %s, %s) = %v, %v, want %v", tt.value, tt.from, tt.to, got, err, tt.want)
+		}
+	}
+	if _, err := Convert(-600, dc, Celsius); !errors.Is(err, ErrBelowAbsoluteZero) {
+		t.Errorf("Convert(-600, %s, C) error = %v, want ErrBelowAbsoluteZero", dc, err)
+	}
+	if s, ok := parseScale("DOUBLE-CELSIUS"); !ok || s != dc {
+		t.Errorf("parseScale(DOUBLE-CELSIUS) = %q, %v, want %q", s, ok, dc)
+	}
+}
+
+func TestRegisterPanics(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		c    Converter
+	}{
+		{"", doubleCelsius{}},
+		{"double-celsius", nil},
+		{"f", doubleCelsius{}},
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Register(%q, %v) did not panic", tt.name, tt.c)
+				}
+			}()
+			Register(tt.name, tt.c)
+		}()
+	}
+}
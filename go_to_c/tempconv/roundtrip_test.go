@@ -0,0 +1,37 @@
+package tempconv
+
+import (
+	"math"
+	"testing"
+)
+
+// TestRoundTrip converts values across the valid range to another scale and
+// back, and checks the original comes back within 1e-9. A sign or offset
+// mistake in either direction shows up as a large delta.
+func TestRoundTrip(t *testing.T) {
+	const tolerance = 1e-9
+	tests := []struct {
+		name        string
+		min         float64
+		there, back func(float64) float64
+	}{
+		{"F->C->F", AbsoluteZeroF, fToC, cToF},
+		{"C->F->C", AbsoluteZeroC, cToF, fToC},
+		{"F->K->F", AbsoluteZeroF, fToK, kToF},
+		{"K->F->K", AbsoluteZeroK, kToF, fToK},
+		{"C->K->C", AbsoluteZeroC, cToK, kToC},
+		{"K->C->K", AbsoluteZeroK, kToC, cToK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// 0.37 is not a binary fraction, so the inputs cover negative,
+			// whole and fractional values.
+			for v := tt.min; v <= 10000; v += 0.37 {
+				got := tt.back(tt.there(v))
+				if d := math.Abs(got - v); d > tolerance {
+					t.Fatalf("round trip of %v gave %v, off by %v", v, got, d)
+				}
+			}
+		})
+	}
+}
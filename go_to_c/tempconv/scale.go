@@ -0,0 +1,211 @@
+package tempconv
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+// Scale identifies a temperature scale. For the built-in scales its value is
+// the short unit symbol; for custom scales it is the name given to Register.
+type Scale string
+
+// Built-in temperature scales. More can be added with Register.
+const (
+	Fahrenheit Scale = "F"
+	Celsius    Scale = "C"
+	Kelvin     Scale = "K"
+	Rankine    Scale = "R"
+	Reaumur    Scale = "Re"
+	Delisle    Scale = "De"
+	Newton     Scale = "N"
+)
+
+// direct holds the pairs that convert in one step, either through a dedicated
+// C function or, for Rankine and Kelvin which share a zero, a single scale
+// factor. Using it instead of going through Celsius avoids a second rounding
+// step, which keeps points like absolute zero exact.
+var direct = map[[2]Scale]func(float64) float64{
+	{Fahrenheit, Celsius}: fToC,
+	{Celsius, Fahrenheit}: cToF,
+	{Celsius, Kelvin}:     cToK,
+	{Kelvin, Celsius}:     kToC,
+	{Fahrenheit, Kelvin}:  fToK,
+	{Kelvin, Fahrenheit}:  kToF,
+	{Fahrenheit, Rankine}: fToR,
+	{Rankine, Fahrenheit}: rToF,
+	{Rankine, Kelvin}:     func(r float64) float64 { return r * 5.0 / 9.0 },
+	{Kelvin, Rankine}:     func(k float64) float64 { return k * 9.0 / 5.0 },
+}
+
+// Convert converts value from one scale to another, resolving both scales
+// through the registry, so custom scales added with Register work too. It
+// returns ErrUnknownUnit if either scale is not known, ErrInvalidInput if
+// value is NaN or infinite, ErrBelowAbsoluteZero if value is colder than
+// absolute zero on the from scale and ErrOverflow if value is larger than
+// MaxMagnitude or the result is not finite. Converting a scale to itself
+// returns value unchanged.
+//
+// Results on the absolute scales, Kelvin and Rankine, are also checked: one
+// below 0 is reported as ErrBelowAbsoluteZero even if value passed its own
+// scale's check, which can happen with custom scales. A result below 0 only
+// by rounding error, as from absolute zero on Réaumur, is returned as 0.
+//
+// Every call is counted in the metrics returned by ReadMetrics. If a Hook is
+// installed with SetHook it is called after every conversion.
+func Convert(value float64, from, to Scale) (float64, error) {
+	h := loadHook()
+	if h == nil {
+		r, err := convert(value, from, to)
+		record(from, to, err)
+		return r, err
+	}
+	start := time.Now()
+	r, err := convert(value, from, to)
+	record(from, to, err)
+	h(from, to, value, r, time.Since(start), err)
+	return r, err
+}
+
+// ConvertClamp is like Convert but saturates instead of failing when value is
+// colder than absolute zero: such a value is replaced by absolute zero on the
+// from scale before converting, so ConvertClamp(-500, Fahrenheit, Kelvin) is
+// 0. It is meant for noisy readings that dip just below absolute zero. Since
+// it hides those errors, use Convert wherever they should be reported. Any
+// other failure, such as an unknown scale, NaN or overflow, gives NaN.
+func ConvertClamp(value float64, from, to Scale) float64 {
+	src, err := lookup(from)
+	if err != nil {
+		return math.NaN()
+	}
+	if errors.Is(checkInput(value, src), ErrBelowAbsoluteZero) {
+		value = absoluteZero(src)
+	}
+	r, err := Convert(value, from, to)
+	if errors.Is(err, ErrBelowAbsoluteZero) {
+		// A custom scale's absolute zero can round to just below the real
+		// one; the answer is still absolute zero on the to scale.
+		dst, err := lookup(to)
+		if err != nil {
+			return math.NaN()
+		}
+		return absoluteZero(dst)
+	}
+	if err != nil {
+		return math.NaN()
+	}
+	return r
+}
+
+func convert(value float64, from, to Scale) (float64, error) {
+	src, err := lookup(from)
+	if err != nil {
+		return 0, err
+	}
+	dst, err := lookup(to)
+	if err != nil {
+		return 0, err
+	}
+	if err := checkInput(value, src); err != nil {
+		return 0, err
+	}
+	if from == to {
+		return value, nil
+	}
+	var r float64
+	if convert, ok := direct[[2]Scale{from, to}]; ok {
+		r = convert(value)
+	} else {
+		r = dst.FromCelsius(src.ToCelsius(value))
+	}
+	if err := checkResult(r); err != nil {
+		return 0, err
+	}
+	if to == Kelvin || to == Rankine {
+		return absoluteResult(r)
+	}
+	return r, nil
+}
+
+// zeroSlack is how far below 0 a Kelvin or Rankine result can land through
+// rounding alone: a few units in the last place of 273.15 and 459.67, the
+// offsets subtracted on the way there. Absolute zero on Réaumur and Delisle
+// has no exact float64 and converts to about -6e-14 K.
+const zeroSlack = 1e-12
+
+// absoluteResult checks r, a result on Kelvin or Rankine. It returns 0 for
+// one that is below 0 only by rounding, and ErrBelowAbsoluteZero for any
+// other negative r.
+func absoluteResult(r float64) (float64, error) {
+	if r >= 0 {
+		return r, nil
+	}
+	if r >= -zeroSlack {
+		return 0, nil
+	}
+	return 0, ErrBelowAbsoluteZero
+}
+
+// ConvertAll converts value from one scale to every known scale, including
+// from itself. The input is validated once and normalized to Celsius once;
+// pairs with a one-step conversion use it as Convert does.
+func ConvertAll(value float64, from Scale) (map[Scale]float64, error) {
+	src, err := lookup(from)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkInput(value, src); err != nil {
+		return nil, err
+	}
+
+	c := src.ToCelsius(value)
+	all := make(map[Scale]float64)
+	for _, to := range Scales() {
+		if to == from {
+			all[to] = value
+			continue
+		}
+		if convert, ok := direct[[2]Scale{from, to}]; ok {
+			all[to] = convert(value)
+			continue
+		}
+		dst, err := lookup(to)
+		if err != nil {
+			return nil, err
+		}
+		all[to] = dst.FromCelsius(c)
+	}
+	for _, r := range all {
+		if err := checkResult(r); err != nil {
+			return nil, err
+		}
+	}
+	for _, to := range []Scale{Kelvin, Rankine} {
+		if all[to], err = absoluteResult(all[to]); err != nil {
+			return nil, err
+		}
+	}
+	return all, nil
+}
+
+// String returns the scale's symbol or registered name, for example "F".
+func (s Scale) String() string {
+	return string(s)
+}
+
+// Set implements flag.Value, so a Scale can be used with flag.Var. It accepts
+// any known scale ignoring case, for example "f", "C" or "re".
+func (s *Scale) Set(v string) error {
+	scale, ok := parseScale(v)
+	if !ok {
+		var names []string
+		for _, known := range Scales() {
+			names = append(names, known.String())
+		}
+		return fmt.Errorf("%w %q, want one of %s", ErrUnknownUnit, v, strings.Join(names, ", "))
+	}
+	*s = scale
+	return nil
+}
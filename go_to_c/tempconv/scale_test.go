@@ -0,0 +1,128 @@
+package tempconv
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"testing"
+)
+
+// fixedPoints gives the freezing and boiling points of water on each
+// built-in scale.
+var fixedPoints = map[Scale][2]float64{
+	Fahrenheit: {32, 212},
+	Celsius:    {0, 100},
+	Kelvin:     {273.15, 373.15},
+	Rankine:    {491.67, 671.67},
+	Reaumur:    {0, 80},
+	Delisle:    {150, 0},
+	Newton:     {0, 33},
+}
+
+func TestConvertEveryPair(t *testing.T) {
+	const epsilon = 1e-9
+	for from, in := range fixedPoints {
+		for to, want := range fixedPoints {
+			for i := range in {
+				got, err := Convert(in[i], from, to)
+				if err != nil {
+					t.Errorf("Convert(%v, %s, %s): %v", in[i], from, to, err)
+					continue
+				}
+				if math.Abs(got-want[i]) > epsilon {
+					t.Errorf("Convert(%v, %s, %s) = %v, want %v", in[i], from, to, got, want[i])
+				}
+			}
+		}
+	}
+}
+
+func TestConvertIdentity(t *testing.T) {
+	for s := range fixedPoints {
+		for _, v := range []float64{0.1, 150, 500} {
+			if got, err := Convert(v, s, s); got != v || err != nil {
+				t.Errorf("Convert(%v, %s, %s) = %v, %v, want %v", v, s, s, got, err, v)
+			}
+		}
+	}
+}
+
+func TestConvertAll(t *testing.T) {
+	const epsilon = 1e-9
+	all, err := ConvertAll(32, Fahrenheit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for s, points := range fixedPoints {
+		if got, ok := all[s]; !ok || math.Abs(got-points[0]) > epsilon {
+			t.Errorf("ConvertAll(32, F)[%s] = %v, %v, want %v", s, got, ok, points[0])
+		}
+	}
+	if all[Celsius] != 0 || all[Kelvin] != 273.15 {
+		t.Errorf("ConvertAll(32, F) = %v °C, %v K, want 0 °C, 273.15 K", all[Celsius], all[Kelvin])
+	}
+}
+
+func TestScaleSet(t *testing.T) {
+	for s := range fixedPoints {
+		for _, in := range []string{string(s), strings.ToLower(string(s)), strings.ToUpper(string(s))} {
+			var got Scale
+			if err := got.Set(in); err != nil || got != s {
+				t.Errorf("Set(%q) = %q, %v, want %q", in, got, err, s)
+			}
+			if got.String() != string(s) {
+				t.Errorf("%q.String() = %q, want %q", s, got.String(), s)
+			}
+		}
+	}
+}
+
+func TestScaleSetUnknown(t *testing.T) {
+	s := Celsius
+	err := s.Set("X")
+	if !errors.Is(err, ErrUnknownUnit) {
+		t.Fatalf("Set(X) error = %v, want ErrUnknownUnit", err)
+	}
+	if want := `tempconv: unknown temperature unit "X", want one of C, De, F, K, N, R, Re`; err.Error() != want {
+		t.Errorf("Set(X) error = %q, want %q", err, want)
+	}
+	if s != Celsius {
+		t.Errorf("Set(X) changed the scale to %q", s)
+	}
+}
+
+func TestConvertClamp(t *testing.T) {
+	tests := []struct {
+		value    float64
+		from, to Scale
+		want     float64
+	}{
+		{-500, Fahrenheit, Kelvin, 0},
+		{-500, Fahrenheit, Celsius, AbsoluteZeroC},
+		{-300, Celsius, Rankine, 0},
+		{600, Delisle, Kelvin, 0},
+		{212, Fahrenheit, Celsius, 100},
+	}
+	const epsilon = 1e-9
+	for _, tt := range tests {
+		if got := ConvertClamp(tt.value, tt.from, tt.to); math.Abs(got-tt.want) > epsilon {
+			t.Errorf("ConvertClamp(%v, %s, %s) = %v, want %v", tt.value, tt.from, tt.to, got, tt.want)
+		}
+	}
+	if got := ConvertClamp(-500, Fahrenheit, Kelvin); got != 0 {
+		t.Errorf("ConvertClamp(-500, F, K) = %v, want exactly 0", got)
+	}
+	for _, tt := range []struct {
+		value    float64
+		from, to Scale
+	}{
+		{math.NaN(), Fahrenheit, Kelvin},
+		{1e300, Fahrenheit, Kelvin},
+		{1, "X", Kelvin},
+		{1, Fahrenheit, "X"},
+	} {
+		if got := ConvertClamp(tt.value, tt.from, tt.to); !math.IsNaN(got) {
+			t.Errorf("ConvertClamp(%v, %s, %s) = %v, want NaN", tt.value, tt.from, tt.to, got)
+		}
+	}
+}
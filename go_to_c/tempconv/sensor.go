@@ -0,0 +1,26 @@
+package tempconv
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// ReadSensor reads a Linux thermal sensor file such as
+// /sys/class/thermal/thermal_zone0/temp, which holds the temperature in
+// millidegrees Celsius as a decimal integer, and returns it converted to
+// scale to. A file that does not hold an integer gives an error wrapping
+// ErrInvalidNumber.
+func ReadSensor(path string, to Scale) (float64, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return 0, fmt.Errorf("tempconv: read sensor: %w", err)
+	}
+	text := strings.TrimSpace(string(data))
+	milli, err := strconv.ParseInt(text, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("sensor %s: %w %q", path, ErrInvalidNumber, text)
+	}
+	return Convert(float64(milli)/1000, Celsius, to)
+}
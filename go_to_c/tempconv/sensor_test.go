@@ -0,0 +1,36 @@
+package tempconv
+
+import (
+	"errors"
+	"io/fs"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestReadSensor(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "temp")
+	if err := os.WriteFile(path, []byte("45000\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	got, err := ReadSensor(path, Fahrenheit)
+	if err != nil || math.Abs(got-113) > 1e-9 {
+		t.Errorf("ReadSensor(45000) = %v, %v, want 113", got, err)
+	}
+}
+
+func TestReadSensorErrors(t *testing.T) {
+	dir := t.TempDir()
+	if _, err := ReadSensor(filepath.Join(dir, "missing"), Celsius); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("ReadSensor(missing) error = %v, want fs.ErrNotExist", err)
+	}
+
+	path := filepath.Join(dir, "temp")
+	if err := os.WriteFile(path, []byte("45.0\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ReadSensor(path, Celsius); !errors.Is(err, ErrInvalidNumber) {
+		t.Errorf("ReadSensor(45.0) error = %v, want ErrInvalidNumber", err)
+	}
+}
@@ -0,0 +1,306 @@
+// Package tempconv converts temperatures between scales.
+//
+// When cgo is enabled the conversions call into the C implementation declared
+// in f_to_c.h. Without cgo (for example when cross-compiling without a C
+// toolchain) a pure-Go copy of the same arithmetic is used instead, so the
+// exported API and results are identical either way.
+//
+// Precision: every conversion is computed in float64 (C double) arithmetic
+// with no integer steps. A result is exact whenever each step of its formula
+// is, which covers the fixed and reference points: FToC(-40) and CToF(-40)
+// are exactly -40, 32°F is exactly 0°C, 212°F is exactly 100°C, any whole
+// Fahrenheit value whose Celsius value is whole converts exactly, and
+// absolute zero on Fahrenheit, Kelvin or Rankine converts exactly to any of
+// those scales and Celsius, as does AbsoluteZeroC to Kelvin. AbsoluteZeroC to
+// Fahrenheit and Rankine is not exact: it gives -459.66999999999996 and
+// 5.684341886080802e-14, one unit in the last place of 459.67 off. Otherwise
+// the error is at most 4 units in the last place of the larger of the input's
+// magnitude and the scale offset involved (32, 273.15 or 459.67), which for
+// inputs between absolute zero and 10000 is under 5e-12 degrees.
+// For example the point where Fahrenheit and Kelvin agree, 574.5875, gives
+// FToK(574.5875) == 574.5875 but KToF(574.5875) == 574.5874999999999.
+// Compare non-reference values with a tolerance, not ==.
+//
+// Concurrency: every function in this package is safe to call from multiple
+// goroutines at once. The C functions in f_to_c.c are reentrant; they only
+// touch their arguments and the buffers passed to them and use no static or
+// global variables. On the Go side the state that can change after
+// initialization is all guarded: the scale registry written by Register is
+// behind a sync.RWMutex, the FToCCached table replaced by SetFToCCacheRange
+// and the hook set by SetHook are swapped atomically through atomic.Value, and
+// the counters behind ReadMetrics are atomic. Every other package-level table,
+// such as the unit symbols and formulas, is never written after
+// initialization. Any state added later must be guarded the same way.
+package tempconv
+
+import (
+	"context"
+	"errors"
+	"math"
+)
+
+// Absolute zero on each of the supported scales.
+const (
+	AbsoluteZeroF = -459.67
+	AbsoluteZeroC = -273.15
+	AbsoluteZeroK = 0.0
+	AbsoluteZeroR = 0.0
+	AbsoluteZeroN = -90.1395
+)
+
+// AbsoluteZeroRe is absolute zero in degrees Réaumur. No float64 is exactly
+// -218.52, so through rounding it converts to Kelvin as a tiny negative
+// value; Convert and ConvertAll return that as 0 K.
+const AbsoluteZeroRe = -218.52
+
+// AbsoluteZeroDe is absolute zero in degrees Delisle. The Delisle scale runs
+// backwards, so this is the largest valid Delisle value rather than the
+// smallest. Like AbsoluteZeroRe it has no exact float64 and is handled the
+// same way.
+const AbsoluteZeroDe = 559.725
+
+// ErrBelowAbsoluteZero is returned when an input temperature is colder than
+// absolute zero on its scale and so cannot be physically meaningful.
+var ErrBelowAbsoluteZero = errors.New("tempconv: temperature below absolute zero")
+
+// ErrInvalidInput is returned when an input temperature is NaN or infinite.
+// It is checked before calling into C.
+var ErrInvalidInput = errors.New("tempconv: input is NaN or infinite")
+
+// ErrOverflow is returned when an input is too large in magnitude for the
+// conversion to give a meaningful result, or when a result is not finite.
+var ErrOverflow = errors.New("tempconv: temperature too large to convert accurately")
+
+// MaxMagnitude is the largest input magnitude the conversions accept, 2^53.
+// Beyond it a float64 can no longer hold every whole number, so the offsets
+// between scales (32, 459.67, 273.15) are partly or wholly rounded away and
+// the result is mostly noise; close to the float64 limit the C arithmetic
+// overflows to infinity outright.
+const MaxMagnitude = 1 << 53
+
+// ErrNegativeDigits is returned by FToCRounded when asked for a negative
+// number of fractional digits.
+var ErrNegativeDigits = errors.New("tempconv: negative number of digits")
+
+// check returns ErrInvalidInput if v is NaN or infinite,
+// ErrBelowAbsoluteZero if v is below min, the absolute zero of its scale, and
+// ErrOverflow if v is larger than MaxMagnitude.
+func check(v, min float64) error {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return ErrInvalidInput
+	}
+	if v < min {
+		return ErrBelowAbsoluteZero
+	}
+	if v > MaxMagnitude {
+		return ErrOverflow
+	}
+	return nil
+}
+
+// checkResult returns ErrOverflow if a conversion result r is NaN or
+// infinite.
+func checkResult(r float64) error {
+	if math.IsNaN(r) || math.IsInf(r, 0) {
+		return ErrOverflow
+	}
+	return nil
+}
+
+// FToC converts a temperature in Fahrenheit to Celsius.
+func FToC(f float64) (float64, error) {
+	if err := check(f, AbsoluteZeroF); err != nil {
+		return 0, err
+	}
+	return fToC(f), nil
+}
+
+// FToC32 converts a temperature in Fahrenheit to Celsius in single precision,
+// using the C f_to_c_f, for callers that work in float32 throughout and would
+// otherwise widen every value to float64 and back. Like FToCBatch it does not
+// check its input; NaN and infinities pass through.
+//
+// Each float32 step rounds to 24 bits, so results are within 2 units in the
+// last place of the float32 Celsius value, plus the error of the input
+// itself (float32 cannot hold 98.6 or -459.67 exactly). Between absolute
+// zero and 1000°F that is under 1e-4 degrees of the float64 result, and
+// under 1e-3 degrees up to 10000°F. The reference points -40, 32 and 212 are
+// still exact. Use FToC when the extra digits matter.
+func FToC32(f float32) float32 {
+	return fToC32(f)
+}
+
+// FToCRounded converts a temperature in Fahrenheit to Celsius like FToC and
+// rounds the result to digits fractional digits, with halves rounded to even.
+// A digits of 0 rounds to the nearest whole degree.
+func FToCRounded(f float64, digits int) (float64, error) {
+	if digits < 0 {
+		return 0, ErrNegativeDigits
+	}
+	c, err := FToC(f)
+	if err != nil {
+		return 0, err
+	}
+	scale := math.Pow10(digits)
+	scaled := c * scale
+	if math.IsInf(scale, 0) || math.IsInf(scaled, 0) {
+		// More digits than a float64 can hold; c is already as precise as
+		// it gets.
+		return c, nil
+	}
+	return math.RoundToEven(scaled) / scale, nil
+}
+
+// CToF converts a temperature in Celsius to Fahrenheit.
+func CToF(c float64) (float64, error) {
+	if err := check(c, AbsoluteZeroC); err != nil {
+		return 0, err
+	}
+	return cToF(c), nil
+}
+
+// CToK converts a temperature in Celsius to Kelvin.
+func CToK(c float64) (float64, error) {
+	if err := check(c, AbsoluteZeroC); err != nil {
+		return 0, err
+	}
+	return cToK(c), nil
+}
+
+// KToC converts a temperature in Kelvin to Celsius.
+func KToC(k float64) (float64, error) {
+	if err := check(k, AbsoluteZeroK); err != nil {
+		return 0, err
+	}
+	return kToC(k), nil
+}
+
+// FToK converts a temperature in Fahrenheit to Kelvin.
+func FToK(f float64) (float64, error) {
+	if err := check(f, AbsoluteZeroF); err != nil {
+		return 0, err
+	}
+	return fToK(f), nil
+}
+
+// KToF converts a temperature in Kelvin to Fahrenheit.
+func KToF(k float64) (float64, error) {
+	if err := check(k, AbsoluteZeroK); err != nil {
+		return 0, err
+	}
+	return kToF(k), nil
+}
+
+// FToR converts a temperature in Fahrenheit to Rankine.
+func FToR(f float64) (float64, error) {
+	if err := check(f, AbsoluteZeroF); err != nil {
+		return 0, err
+	}
+	return fToR(f), nil
+}
+
+// RToF converts a temperature in Rankine to Fahrenheit.
+func RToF(r float64) (float64, error) {
+	if err := check(r, AbsoluteZeroR); err != nil {
+		return 0, err
+	}
+	return rToF(r), nil
+}
+
+// CToRe converts a temperature in Celsius to Réaumur.
+func CToRe(c float64) (float64, error) {
+	if err := check(c, AbsoluteZeroC); err != nil {
+		return 0, err
+	}
+	return cToRe(c), nil
+}
+
+// ReToC converts a temperature in Réaumur to Celsius.
+func ReToC(re float64) (float64, error) {
+	if err := check(re, AbsoluteZeroRe); err != nil {
+		return 0, err
+	}
+	return reToC(re), nil
+}
+
+// CToDe converts a temperature in Celsius to Delisle.
+func CToDe(c float64) (float64, error) {
+	if err := check(c, AbsoluteZeroC); err != nil {
+		return 0, err
+	}
+	return cToDe(c), nil
+}
+
+// DeToC converts a temperature in Delisle to Celsius. Delisle values above
+// AbsoluteZeroDe are colder than absolute zero.
+func DeToC(de float64) (float64, error) {
+	// Negating turns the inverted bound into the usual lower one.
+	if err := check(-de, -AbsoluteZeroDe); err != nil {
+		return 0, err
+	}
+	return deToC(de), nil
+}
+
+// CToN converts a temperature in Celsius to Newton.
+func CToN(c float64) (float64, error) {
+	if err := check(c, AbsoluteZeroC); err != nil {
+		return 0, err
+	}
+	return cToN(c), nil
+}
+
+// NToC converts a temperature in Newton to Celsius.
+func NToC(n float64) (float64, error) {
+	if err := check(n, AbsoluteZeroN); err != nil {
+		return 0, err
+	}
+	return nToC(n), nil
+}
+
+// FToCBatch converts every Fahrenheit value in in to Celsius. With cgo it
+// makes a single call to the C f_to_c_batch, which is much cheaper than
+// crossing into C once per value. Inputs are not checked against absolute
+// zero.
+//
+// The returned slice is newly allocated Go memory owned by the caller; any C
+// memory used along the way is freed before FToCBatch returns.
+func FToCBatch(in []float64) []float64 {
+	out := make([]float64, len(in))
+	fToCBatch(in, out)
+	return out
+}
+
+// FToCInPlace converts every Fahrenheit value in vals to Celsius, overwriting
+// vals. Nothing is allocated: with cgo the slice's own memory is passed to a
+// single C call. Like FToCBatch, it does not check inputs against absolute
+// zero.
+func FToCInPlace(vals []float64) {
+	fToCInPlace(vals)
+}
+
+// batchChunk is how many values FToCBatchCtx converts between checks of its
+// context.
+const batchChunk = 4096
+
+// FToCBatchCtx is like FToCBatch but gives up when ctx is done, checking it
+// before every batchChunk values. On cancellation it returns nil and
+// ctx.Err(), even if in is empty; partial results are discarded.
+func FToCBatchCtx(ctx context.Context, in []float64) ([]float64, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	out := make([]float64, len(in))
+	for start := 0; start < len(in); start += batchChunk {
+		if start > 0 {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+		}
+		end := start + batchChunk
+		if end > len(in) {
+			end = len(in)
+		}
+		fToCBatch(in[start:end], out[start:end])
+	}
+	return out, nil
+}
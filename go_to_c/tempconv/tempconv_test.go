@@ -0,0 +1,139 @@
+package tempconv
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestFToCRoundTrip(t *testing.T) {
+	const epsilon = 1e-9
+	for _, f := range []float64{32, 98.6, -40} {
+		c, err := FToC(f)
+		if err != nil {
+			t.Fatalf("FToC(%v): %v", f, err)
+		}
+		got, err := CToF(c)
+		if err != nil {
+			t.Fatalf("CToF(%v): %v", c, err)
+		}
+		if math.Abs(got-f) > epsilon {
+			t.Errorf("CToF(FToC(%v)) = %v, want %v", f, got, f)
+		}
+	}
+}
+
+// TestFToCKeepsFraction checks f_to_c works on doubles; the old int version
+// dropped the fraction of the input.
+func TestFToCKeepsFraction(t *testing.T) {
+	const epsilon = 1e-9
+	for _, tt := range []struct{ f, want float64 }{
+		{33.8, 1.0},
+		{98.6, 37.0},
+	} {
+		if got := fToC(tt.f); math.Abs(got-tt.want) > epsilon {
+			t.Errorf("f_to_c(%v) = %v, want %v", tt.f, got, tt.want)
+		}
+	}
+}
+
+func TestKelvin(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   func(float64) (float64, error)
+		in   float64
+		want float64
+	}{
+		{"CToK", CToK, 0, 273.15},
+		{"CToK", CToK, -273.15, 0},
+		{"KToC", KToC, 273.15, 0},
+		{"KToC", KToC, 0, -273.15},
+	}
+	for _, tt := range tests {
+		got, err := tt.fn(tt.in)
+		if err != nil || got != tt.want {
+			t.Errorf("%s(%v) = %v, %v, want %v", tt.name, tt.in, got, err, tt.want)
+		}
+	}
+}
+
+func TestAbsoluteZeroBound(t *testing.T) {
+	if got, err := FToC(-459.67); err != nil || got != AbsoluteZeroC {
+		t.Errorf("FToC(-459.67) = %v, %v, want %v", got, err, AbsoluteZeroC)
+	}
+	if _, err := FToC(-460); !errors.Is(err, ErrBelowAbsoluteZero) {
+		t.Errorf("FToC(-460) error = %v, want ErrBelowAbsoluteZero", err)
+	}
+}
+
+// TestCFunctions checks the C conversion functions directly, without the
+// input and result checks of the exported wrappers.
+func TestCFunctions(t *testing.T) {
+	const epsilon = 1e-9
+	tests := []struct {
+		name string
+		fn   func(float64) float64
+		in   float64
+		want float64
+	}{
+		{"f_to_r", fToR, 32, 491.67},
+		{"r_to_f", rToF, 491.67, 32},
+		{"c_to_re", cToRe, 100, 80},
+		{"re_to_c", reToC, 80, 100},
+		{"c_to_de", cToDe, 100, 0},
+		{"de_to_c", deToC, 150, 0},
+		{"c_to_n", cToN, 100, 33},
+		{"n_to_c", nToC, 33, 100},
+	}
+	for _, tt := range tests {
+		if got := tt.fn(tt.in); math.Abs(got-tt.want) > epsilon {
+			t.Errorf("%s(%v) = %v, want %v", tt.name, tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestFToCRounded(t *testing.T) {
+	tests := []struct {
+		f      float64
+		digits int
+		want   float64
+	}{
+		{98.6, 1, 37.0},
+		{33.8, 0, 1},
+		{100, 2, 37.78},
+		{212, 0, 100},
+	}
+	for _, tt := range tests {
+		if got, err := FToCRounded(tt.f, tt.digits); err != nil || got != tt.want {
+			t.Errorf("FToCRounded(%v, %d) = %v, %v, want %v", tt.f, tt.digits, got, err, tt.want)
+		}
+	}
+	if _, err := FToCRounded(98.6, -1); !errors.Is(err, ErrNegativeDigits) {
+		t.Errorf("FToCRounded(98.6, -1) error = %v, want ErrNegativeDigits", err)
+	}
+}
+
+func TestInvalidInput(t *testing.T) {
+	for _, v := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+		if _, err := FToC(v); !errors.Is(err, ErrInvalidInput) {
+			t.Errorf("FToC(%v) error = %v, want ErrInvalidInput", v, err)
+		}
+		if _, err := Convert(v, Celsius, Kelvin); !errors.Is(err, ErrInvalidInput) {
+			t.Errorf("Convert(%v, C, K) error = %v, want ErrInvalidInput", v, err)
+		}
+	}
+}
+
+func TestOverflow(t *testing.T) {
+	for _, v := range []float64{1e300, 1e308, MaxMagnitude * 2} {
+		if _, err := FToC(v); !errors.Is(err, ErrOverflow) {
+			t.Errorf("FToC(%v) error = %v, want ErrOverflow", v, err)
+		}
+		if _, err := Convert(v, Kelvin, Rankine); !errors.Is(err, ErrOverflow) {
+			t.Errorf("Convert(%v, K, R) error = %v, want ErrOverflow", v, err)
+		}
+	}
+	if _, err := FToC(MaxMagnitude); err != nil {
+		t.Errorf("FToC(MaxMagnitude) error = %v, want nil", err)
+	}
+}
@@ -0,0 +1,88 @@
+package tempconv
+
+import (
+	"cmp"
+	"encoding/json"
+	"math"
+)
+
+// Temperature is a value on a particular scale. In JSON it is an object with
+// the value and the scale's unit symbol, for example {"value":37,"unit":"C"}.
+type Temperature struct {
+	Value float64
+	Scale Scale
+}
+
+type temperatureJSON struct {
+	Value float64 `json:"value"`
+	Unit  Scale   `json:"unit"`
+}
+
+// To returns t converted to scale s. See Convert for the errors it can
+// return.
+func (t Temperature) To(s Scale) (Temperature, error) {
+	v, err := Convert(t.Value, t.Scale, s)
+	if err != nil {
+		return Temperature{}, err
+	}
+	return Temperature{Value: v, Scale: s}, nil
+}
+
+// Compare returns -1, 0 or +1 as t is colder than, as warm as, or warmer than
+// other, whatever scales they are on. Both are converted to Celsius first, so
+// Temperature{32, Fahrenheit} compares equal to Temperature{0, Celsius}. The
+// comparison is exact; values that only agree up to rounding, such as a
+// Fahrenheit value converted to Kelvin and back, may not compare equal, so use
+// Equal for those.
+//
+// Following cmp.Compare, a NaN value, or a scale that is not known, sorts
+// before everything else and compares equal to another such temperature.
+func (t Temperature) Compare(other Temperature) int {
+	return cmp.Compare(t.celsius(), other.celsius())
+}
+
+// Equal reports whether t and other are within epsilon Celsius degrees of each
+// other. A temperature with a NaN value or unknown scale equals nothing.
+func (t Temperature) Equal(other Temperature, epsilon float64) bool {
+	return math.Abs(t.celsius()-other.celsius()) <= epsilon
+}
+
+// Less reports whether t is colder than other, as Compare orders them. It
+// makes sorting a mixed-scale slice a one-liner:
+//
+//	sort.Slice(ts, func(i, j int) bool { return ts[i].Less(ts[j]) })
+func (t Temperature) Less(other Temperature) bool {
+	return t.Compare(other) < 0
+}
+
+// celsius returns t in Celsius, or NaN if its scale is not known.
+func (t Temperature) celsius() float64 {
+	c, err := lookup(t.Scale)
+	if err != nil {
+		return math.NaN()
+	}
+	return c.ToCelsius(t.Value)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t Temperature) MarshalJSON() ([]byte, error) {
+	if _, err := lookup(t.Scale); err != nil {
+		return nil, err
+	}
+	return json.Marshal(temperatureJSON{Value: t.Value, Unit: t.Scale})
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It returns ErrUnknownUnit if the
+// unit is missing or not a known scale.
+func (t *Temperature) UnmarshalJSON(data []byte) error {
+	var aux temperatureJSON
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	if _, err := lookup(aux.Unit); err != nil {
+		return err
+	}
+	t.Value = aux.Value
+	t.Scale = aux.Unit
+	return nil
+}
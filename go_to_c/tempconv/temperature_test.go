@@ -0,0 +1,107 @@
+package tempconv
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"sort"
+	"testing"
+)
+
+func TestTemperatureJSON(t *testing.T) {
+	in := Temperature{Value: 273.15, Scale: Kelvin}
+	data, err := json.Marshal(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"value":273.15,"unit":"K"}`; string(data) != want {
+		t.Errorf("Marshal = %s, want %s", data, want)
+	}
+
+	var out Temperature
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatalf("Unmarshal(%s): %v", data, err)
+	}
+	if out != in {
+		t.Errorf("Unmarshal(%s) = %+v, want %+v", data, out, in)
+	}
+	f, err := out.To(Fahrenheit)
+	if err != nil || f.Scale != Fahrenheit || math.Abs(f.Value-32) > 1e-9 {
+		t.Errorf("%+v.To(F) = %+v, %v, want 32 F", out, f, err)
+	}
+}
+
+func TestTemperatureJSONUnknownUnit(t *testing.T) {
+	for _, data := range []string{`{"value":1,"unit":"X"}`, `{"value":1}`} {
+		var tmp Temperature
+		if err := json.Unmarshal([]byte(data), &tmp); !errors.Is(err, ErrUnknownUnit) {
+			t.Errorf("Unmarshal(%s) error = %v, want ErrUnknownUnit", data, err)
+		}
+	}
+	if _, err := json.Marshal(Temperature{Value: 1, Scale: "X"}); !errors.Is(err, ErrUnknownUnit) {
+		t.Errorf("Marshal with unknown scale error = %v, want ErrUnknownUnit", err)
+	}
+}
+
+func TestTemperatureCompare(t *testing.T) {
+	tests := []struct {
+		a, b Temperature
+		want int
+	}{
+		{Temperature{32, Fahrenheit}, Temperature{0, Celsius}, 0},
+		{Temperature{100, Celsius}, Temperature{200, Fahrenheit}, +1},
+		{Temperature{200, Fahrenheit}, Temperature{100, Celsius}, -1},
+		{Temperature{0, Kelvin}, Temperature{-459.67, Fahrenheit}, 0},
+		{Temperature{1, "X"}, Temperature{AbsoluteZeroK, Kelvin}, -1},
+	}
+	for _, tt := range tests {
+		if got := tt.a.Compare(tt.b); got != tt.want {
+			t.Errorf("%v.Compare(%v) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+		if got := tt.a.Less(tt.b); got != (tt.want < 0) {
+			t.Errorf("%v.Less(%v) = %v, want %v", tt.a, tt.b, got, tt.want < 0)
+		}
+	}
+}
+
+func TestTemperatureEqual(t *testing.T) {
+	f := Temperature{98.6, Fahrenheit}
+	k, err := f.To(Kelvin)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !f.Equal(k, 1e-9) {
+		t.Errorf("%v.Equal(%v, 1e-9) = false, want true", f, k)
+	}
+	if f.Equal(Temperature{37.1, Celsius}, 0.05) {
+		t.Errorf("%v.Equal(37.1 C, 0.05) = true, want false", f)
+	}
+	if nan := (Temperature{math.NaN(), Celsius}); nan.Equal(nan, 1) {
+		t.Errorf("NaN temperature equals itself")
+	}
+}
+
+func TestTemperatureSort(t *testing.T) {
+	ts := []Temperature{
+		{100, Celsius},
+		{0, Kelvin},
+		{32, Fahrenheit},
+		{-40, Celsius},
+		{200, Fahrenheit},
+		{300, Kelvin},
+	}
+	sort.Slice(ts, func(i, j int) bool { return ts[i].Less(ts[j]) })
+	want := []Temperature{
+		{0, Kelvin},
+		{-40, Celsius},
+		{32, Fahrenheit},
+		{300, Kelvin},
+		{200, Fahrenheit},
+		{100, Celsius},
+	}
+	for i := range ts {
+		if ts[i] != want[i] {
+			t.Fatalf("sorted = %v, want %v", ts, want)
+		}
+	}
+}
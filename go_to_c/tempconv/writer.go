@@ -0,0 +1,77 @@
+package tempconv
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+type converterWriter struct {
+	w        io.Writer
+	from, to Scale
+	buf      []byte
+	line     int
+}
+
+// NewConverterWriter returns a writer that treats everything written to it as
+// newline-separated temperatures on the from scale and writes each one to w
+// converted to the to scale, one per line. Blank lines are dropped.
+//
+// A line is converted as soon as its newline arrives, so a number may be
+// split across any number of Write calls. Close converts a final line that
+// has no trailing newline; it does not close w.
+//
+// If a line cannot be parsed or converted, Write returns an error naming the
+// line and drops it; later lines are still converted.
+func NewConverterWriter(w io.Writer, from, to Scale) io.WriteCloser {
+	return &converterWriter{w: w, from: from, to: to}
+}
+
+func (cw *converterWriter) Write(p []byte) (int, error) {
+	cw.buf = append(cw.buf, p...)
+	var err error
+	start := 0
+	for err == nil {
+		i := bytes.IndexByte(cw.buf[start:], '\n')
+		if i < 0 {
+			break
+		}
+		err = cw.convertLine(cw.buf[start : start+i])
+		start += i + 1
+	}
+	// Keep only the unterminated tail, reusing the buffer's storage.
+	cw.buf = cw.buf[:copy(cw.buf, cw.buf[start:])]
+	return len(p), err
+}
+
+func (cw *converterWriter) Close() error {
+	// The buffer may still hold complete lines if the last Write stopped
+	// at an error, so convert every line, not just the unterminated tail.
+	var first error
+	for _, line := range bytes.Split(cw.buf, []byte{'\n'}) {
+		if err := cw.convertLine(line); err != nil && first == nil {
+			first = err
+		}
+	}
+	cw.buf = cw.buf[:0]
+	return first
+}
+
+func (cw *converterWriter) convertLine(line []byte) error {
+	cw.line++
+	text := string(bytes.TrimSpace(line))
+	if text == "" {
+		return nil
+	}
+	f, err := strconv.ParseFloat(text, 64)
+	if err != nil {
+		return fmt.Errorf("line %d: %w %q", cw.line, ErrInvalidNumber, text)
+	}
+	v, err := Convert(f, cw.from, cw.to)
+	if err != nil {
+		return fmt.Errorf("line %d: %w", cw.line, err)
+	}
+	_, err = io.WriteString(cw.w, strconv.FormatFloat(v, 'g', -1, 64)+"\n")
+	return err
+}
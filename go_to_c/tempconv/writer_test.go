@@ -0,0 +1,39 @@
+package tempconv
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func TestConverterWriterChunked(t *testing.T) {
+	var out bytes.Buffer
+	w := NewConverterWriter(&out, Fahrenheit, Celsius)
+	// 212 is split across three writes and the last line has no newline.
+	for _, chunk := range []string{"32\n2", "1", "2\n\n-4", "0"} {
+		if _, err := w.Write([]byte(chunk)); err != nil {
+			t.Fatalf("Write(%q): %v", chunk, err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	if got, want := out.String(), "0\n100\n-40\n"; got != want {
+		t.Errorf("output = %q, want %q", got, want)
+	}
+}
+
+func TestConverterWriterBadLine(t *testing.T) {
+	var out bytes.Buffer
+	w := NewConverterWriter(&out, Fahrenheit, Celsius)
+	_, err := w.Write([]byte("32\nabc\n212\n"))
+	if !errors.Is(err, ErrInvalidNumber) || err.Error() != `line 2: tempconv: invalid number "abc"` {
+		t.Errorf("Write error = %v, want line 2 ErrInvalidNumber", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	if got, want := out.String(), "0\n100\n"; got != want {
+		t.Errorf("output = %q, want %q", got, want)
+	}
+}
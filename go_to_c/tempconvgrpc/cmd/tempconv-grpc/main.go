@@ -0,0 +1,31 @@
+// Command tempconv-grpc serves the TempConv gRPC service.
+//
+//	tempconv-grpc -addr=:50051
+package main
+
+import (
+	"flag"
+	"log"
+	"net"
+
+	"google.golang.org/grpc"
+
+	"github.com/avr1/lsd_ceph/go_to_c/tempconvgrpc"
+	"github.com/avr1/lsd_ceph/go_to_c/tempconvgrpc/tempconvpb"
+)
+
+func main() {
+	addr := flag.String("addr", ":50051", "address to listen on")
+	flag.Parse()
+
+	lis, err := net.Listen("tcp", *addr)
+	if err != nil {
+		log.Fatal(err)
+	}
+	s := grpc.NewServer()
+	tempconvpb.RegisterTempConvServer(s, tempconvgrpc.Server{})
+	log.Printf("tempconv-grpc listening on %s", lis.Addr())
+	if err := s.Serve(lis); err != nil {
+		log.Fatal(err)
+	}
+}
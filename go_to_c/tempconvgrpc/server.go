@@ -0,0 +1,44 @@
+// Package tempconvgrpc implements the TempConv gRPC service on top of
+// tempconv.Convert.
+package tempconvgrpc
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/avr1/lsd_ceph/go_to_c/tempconv"
+	"github.com/avr1/lsd_ceph/go_to_c/tempconvgrpc/tempconvpb"
+)
+
+// Server is a tempconvpb.TempConvServer. Register it with
+//
+//	tempconvpb.RegisterTempConvServer(s, tempconvgrpc.Server{})
+type Server struct {
+	tempconvpb.UnimplementedTempConvServer
+}
+
+// Convert converts req.Value between the requested scales with
+// tempconv.Convert. Scales are matched ignoring case. Unknown scales and NaN
+// or infinite values are reported as codes.InvalidArgument, and values below
+// absolute zero or too large to convert as codes.OutOfRange.
+func (Server) Convert(ctx context.Context, req *tempconvpb.ConvertRequest) (*tempconvpb.ConvertResponse, error) {
+	var from, to tempconv.Scale
+	if err := from.Set(req.GetFromScale()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "from_scale: %v", err)
+	}
+	if err := to.Set(req.GetToScale()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "to_scale: %v", err)
+	}
+
+	v, err := tempconv.Convert(req.GetValue(), from, to)
+	switch {
+	case errors.Is(err, tempconv.ErrBelowAbsoluteZero), errors.Is(err, tempconv.ErrOverflow):
+		return nil, status.Error(codes.OutOfRange, err.Error())
+	case err != nil:
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &tempconvpb.ConvertResponse{Value: v}, nil
+}
@@ -0,0 +1,63 @@
+package tempconvgrpc
+
+import (
+	"context"
+	"math"
+	"net"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/avr1/lsd_ceph/go_to_c/tempconvgrpc/tempconvpb"
+)
+
+// dial starts a Server on an in-memory listener and returns a client for it.
+func dial(t *testing.T) tempconvpb.TempConvClient {
+	lis := bufconn.Listen(1 << 20)
+	s := grpc.NewServer()
+	tempconvpb.RegisterTempConvServer(s, Server{})
+	go s.Serve(lis)
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return tempconvpb.NewTempConvClient(conn)
+}
+
+func TestConvert(t *testing.T) {
+	client := dial(t)
+	tests := []struct {
+		req  *tempconvpb.ConvertRequest
+		want float64
+		code codes.Code
+	}{
+		{&tempconvpb.ConvertRequest{Value: 212, FromScale: "F", ToScale: "C"}, 100, codes.OK},
+		{&tempconvpb.ConvertRequest{Value: 0, FromScale: "c", ToScale: "k"}, 273.15, codes.OK},
+		{&tempconvpb.ConvertRequest{Value: -500, FromScale: "F", ToScale: "C"}, 0, codes.OutOfRange},
+		{&tempconvpb.ConvertRequest{Value: 1e300, FromScale: "F", ToScale: "C"}, 0, codes.OutOfRange},
+		{&tempconvpb.ConvertRequest{Value: 1, FromScale: "X", ToScale: "C"}, 0, codes.InvalidArgument},
+		{&tempconvpb.ConvertRequest{Value: 1, FromScale: "F"}, 0, codes.InvalidArgument},
+		{&tempconvpb.ConvertRequest{Value: math.NaN(), FromScale: "F", ToScale: "C"}, 0, codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		resp, err := client.Convert(context.Background(), tt.req)
+		if code := status.Code(err); code != tt.code {
+			t.Errorf("Convert(%v) code = %v (%v), want %v", tt.req, code, err, tt.code)
+			continue
+		}
+		if err == nil && math.Abs(resp.GetValue()-tt.want) > 1e-9 {
+			t.Errorf("Convert(%v) = %v, want %v", tt.req, resp.GetValue(), tt.want)
+		}
+	}
+}
@@ -0,0 +1,5 @@
+// Package tempconvpb holds the protocol buffer and gRPC definitions of the
+// TempConv service, generated from tempconv.proto.
+package tempconvpb
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative tempconv.proto
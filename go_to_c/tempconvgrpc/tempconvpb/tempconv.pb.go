@@ -0,0 +1,194 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: tempconv.proto
+
+package tempconvpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ConvertRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Value float64                `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"`
+	// Scale symbols as used by the tempconv package, matched ignoring case:
+	// "F", "C", "K", "R", "Re", "De", "N" or a registered custom scale.
+	FromScale     string `protobuf:"bytes,2,opt,name=from_scale,json=fromScale,proto3" json:"from_scale,omitempty"`
+	ToScale       string `protobuf:"bytes,3,opt,name=to_scale,json=toScale,proto3" json:"to_scale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConvertRequest) Reset() {
+	*x = ConvertRequest{}
+	mi := &file_tempconv_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConvertRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConvertRequest) ProtoMessage() {}
+
+func (x *ConvertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tempconv_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConvertRequest.ProtoReflect.Descriptor instead.
+func (*ConvertRequest) Descriptor() ([]byte, []int) {
+	return file_tempconv_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ConvertRequest) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *ConvertRequest) GetFromScale() string {
+	if x != nil {
+		return x.FromScale
+	}
+	return ""
+}
+
+func (x *ConvertRequest) GetToScale() string {
+	if x != nil {
+		return x.ToScale
+	}
+	return ""
+}
+
+type ConvertResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         float64                `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConvertResponse) Reset() {
+	*x = ConvertResponse{}
+	mi := &file_tempconv_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConvertResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConvertResponse) ProtoMessage() {}
+
+func (x *ConvertResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tempconv_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConvertResponse.ProtoReflect.Descriptor instead.
+func (*ConvertResponse) Descriptor() ([]byte, []int) {
+	return file_tempconv_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ConvertResponse) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+var File_tempconv_proto protoreflect.FileDescriptor
+
+const file_tempconv_proto_rawDesc = "" +
+	"\n" +
+	"\x0etempconv.proto\x12\btempconv\"`\n" +
+	"\x0eConvertRequest\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\x01R\x05value\x12\x1d\n" +
+	"\n" +
+	"from_scale\x18\x02 \x01(\tR\tfromScale\x12\x19\n" +
+	"\bto_scale\x18\x03 \x01(\tR\atoScale\"'\n" +
+	"\x0fConvertResponse\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\x01R\x05value2J\n" +
+	"\bTempConv\x12>\n" +
+	"\aConvert\x12\x18.tempconv.ConvertRequest\x1a\x19.tempconv.ConvertResponseB:Z8github.com/avr1/lsd_ceph/go_to_c/tempconvgrpc/tempconvpbb\x06proto3"
+
+var (
+	file_tempconv_proto_rawDescOnce sync.Once
+	file_tempconv_proto_rawDescData []byte
+)
+
+func file_tempconv_proto_rawDescGZIP() []byte {
+	file_tempconv_proto_rawDescOnce.Do(func() {
+		file_tempconv_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_tempconv_proto_rawDesc), len(file_tempconv_proto_rawDesc)))
+	})
+	return file_tempconv_proto_rawDescData
+}
+
+var file_tempconv_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_tempconv_proto_goTypes = []any{
+	(*ConvertRequest)(nil),  // 0: tempconv.ConvertRequest
+	(*ConvertResponse)(nil), // 1: tempconv.ConvertResponse
+}
+var file_tempconv_proto_depIdxs = []int32{
+	0, // 0: tempconv.TempConv.Convert:input_type -> tempconv.ConvertRequest
+	1, // 1: tempconv.TempConv.Convert:output_type -> tempconv.ConvertResponse
+	1, // [1:2] is the sub-list for method output_type
+	0, // [0:1] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_tempconv_proto_init() }
+func file_tempconv_proto_init() {
+	if File_tempconv_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_tempconv_proto_rawDesc), len(file_tempconv_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_tempconv_proto_goTypes,
+		DependencyIndexes: file_tempconv_proto_depIdxs,
+		MessageInfos:      file_tempconv_proto_msgTypes,
+	}.Build()
+	File_tempconv_proto = out.File
+	file_tempconv_proto_goTypes = nil
+	file_tempconv_proto_depIdxs = nil
+}
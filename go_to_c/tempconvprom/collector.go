@@ -0,0 +1,52 @@
+// Package tempconvprom exports the tempconv conversion counters to
+// Prometheus. It is a separate package so that programs using only the
+// expvar counters do not depend on the Prometheus client.
+//
+//	prometheus.MustRegister(tempconvprom.NewCollector())
+package tempconvprom
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/avr1/lsd_ceph/go_to_c/tempconv"
+)
+
+var (
+	conversionsDesc = prometheus.NewDesc(
+		"tempconv_conversions_total",
+		"Number of temperature conversions, successful or not.",
+		nil, nil)
+	errorsDesc = prometheus.NewDesc(
+		"tempconv_conversion_errors_total",
+		"Number of temperature conversions that returned an error.",
+		nil, nil)
+	pairDesc = prometheus.NewDesc(
+		"tempconv_pair_conversions_total",
+		"Number of temperature conversions by scale pair.",
+		[]string{"from", "to"}, nil)
+)
+
+// collector reads tempconv.ReadMetrics on every scrape, so the values always
+// match the expvar output.
+type collector struct{}
+
+// NewCollector returns a prometheus.Collector for the tempconv counters.
+func NewCollector() prometheus.Collector {
+	return collector{}
+}
+
+func (collector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- conversionsDesc
+	ch <- errorsDesc
+	ch <- pairDesc
+}
+
+func (collector) Collect(ch chan<- prometheus.Metric) {
+	m := tempconv.ReadMetrics()
+	ch <- prometheus.MustNewConstMetric(conversionsDesc, prometheus.CounterValue, float64(m.Conversions))
+	ch <- prometheus.MustNewConstMetric(errorsDesc, prometheus.CounterValue, float64(m.Errors))
+	for p, n := range m.Pairs {
+		ch <- prometheus.MustNewConstMetric(pairDesc, prometheus.CounterValue, float64(n),
+			p.From.String(), p.To.String())
+	}
+}
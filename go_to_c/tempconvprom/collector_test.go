@@ -0,0 +1,38 @@
+package tempconvprom
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/avr1/lsd_ceph/go_to_c/tempconv"
+)
+
+func TestCollector(t *testing.T) {
+	reg := prometheus.NewPedanticRegistry()
+	reg.MustRegister(NewCollector())
+
+	// Nothing else in this test binary converts, so the counts are exact.
+	tempconv.Convert(212, tempconv.Fahrenheit, tempconv.Celsius)
+	tempconv.Convert(32, tempconv.Fahrenheit, tempconv.Celsius)
+	tempconv.Convert(-500, tempconv.Fahrenheit, tempconv.Kelvin)
+	tempconv.Convert(1, "X", tempconv.Celsius)
+
+	want := `
+# HELP tempconv_conversion_errors_total Number of temperature conversions that returned an error.
+# TYPE tempconv_conversion_errors_total counter
+tempconv_conversion_errors_total 2
+# HELP tempconv_conversions_total Number of temperature conversions, successful or not.
+# TYPE tempconv_conversions_total counter
+tempconv_conversions_total 4
+# HELP tempconv_pair_conversions_total Number of temperature conversions by scale pair.
+# TYPE tempconv_pair_conversions_total counter
+tempconv_pair_conversions_total{from="F",to="C"} 2
+tempconv_pair_conversions_total{from="F",to="K"} 1
+`
+	if err := testutil.GatherAndCompare(reg, strings.NewReader(want)); err != nil {
+		t.Error(err)
+	}
+}
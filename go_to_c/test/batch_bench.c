@@ -0,0 +1,65 @@
+/* Times f_to_c_batch against a plain loop of f_to_c calls on a large array
+ * and checks that both give bit-identical results. Build and run with
+ * `make bench-clib`. */
+#include <stdio.h>
+#include <stdlib.h>
+#include <string.h>
+#include <time.h>
+
+#include "f_to_c.h"
+
+#define N (1 << 20)
+#define ROUNDS 100
+
+static double seconds(void) {
+   struct timespec ts;
+   clock_gettime(CLOCK_MONOTONIC, &ts);
+   return ts.tv_sec + ts.tv_nsec / 1e9;
+}
+
+int main(void) {
+   double* in = malloc(N * sizeof(double));
+   double* scalar = malloc(N * sizeof(double));
+   double* batch = malloc(N * sizeof(double));
+   double start, t_scalar, t_batch;
+   int r;
+   size_t i;
+
+   if (in == NULL || scalar == NULL || batch == NULL) {
+      fprintf(stderr, "out of memory\n");
+      return 1;
+   }
+   /* Spread the inputs from absolute zero to well above boiling, with
+    * fractions that do not convert exactly. */
+   for (i = 0; i < N; i++) {
+      in[i] = -459.67 + (double)i * 0.0137;
+   }
+
+   start = seconds();
+   for (r = 0; r < ROUNDS; r++) {
+      for (i = 0; i < N; i++) {
+         scalar[i] = f_to_c(in[i]);
+      }
+   }
+   t_scalar = seconds() - start;
+
+   start = seconds();
+   for (r = 0; r < ROUNDS; r++) {
+      f_to_c_batch(in, batch, N);
+   }
+   t_batch = seconds() - start;
+
+   if (memcmp(scalar, batch, N * sizeof(double)) != 0) {
+      for (i = 0; i < N && scalar[i] == batch[i]; i++) {
+      }
+      fprintf(stderr, "f_to_c_batch(%.17g) = %.17g, f_to_c gives %.17g\n",
+              in[i], batch[i], scalar[i]);
+      return 1;
+   }
+   printf("scalar: %.2f ns/value\n", t_scalar * 1e9 / ((double)N * ROUNDS));
+   printf("batch:  %.2f ns/value\n", t_batch * 1e9 / ((double)N * ROUNDS));
+   free(in);
+   free(scalar);
+   free(batch);
+   return 0;
+}
@@ -0,0 +1,67 @@
+/* Links against the static library built in tempconv and checks that the
+ * installed header declares every conversion function, by taking the address
+ * of each one: a missing or changed prototype fails to compile. Build and
+ * run with `make test-clib`. */
+#include <math.h>
+#include <stdio.h>
+
+#include "f_to_c.h"
+
+struct pair {
+   const char* name;
+   double (*there)(double);
+   double (*back)(double);
+};
+
+static const struct pair pairs[] = {
+   {"f_to_c/c_to_f", f_to_c, c_to_f},
+   {"c_to_k/k_to_c", c_to_k, k_to_c},
+   {"f_to_k/k_to_f", f_to_k, k_to_f},
+   {"f_to_r/r_to_f", f_to_r, r_to_f},
+   {"c_to_re/re_to_c", c_to_re, re_to_c},
+   {"c_to_de/de_to_c", c_to_de, de_to_c},
+   {"c_to_n/n_to_c", c_to_n, n_to_c},
+};
+
+int main(void) {
+   float (*single)(float) = f_to_c_f;
+   void (*batch)(const double*, double*, size_t) = f_to_c_batch;
+   void (*inplace)(double*, size_t) = f_to_c_inplace;
+   double in[] = {32, 212, -40};
+   double out[3];
+   int status = 0;
+   size_t i;
+
+   if (f_to_c(212.0) != 100.0) {
+      fprintf(stderr, "f_to_c(212.0) = %f, want 100.0\n", f_to_c(212.0));
+      status = 1;
+   }
+   if (c_to_k(0.0) != 273.15) {
+      fprintf(stderr, "c_to_k(0.0) = %f, want 273.15\n", c_to_k(0.0));
+      status = 1;
+   }
+   for (i = 0; i < sizeof pairs / sizeof pairs[0]; i++) {
+      double got = pairs[i].back(pairs[i].there(37.5));
+      if (fabs(got - 37.5) > 1e-9) {
+         fprintf(stderr, "%s: round trip of 37.5 gave %.17g\n", pairs[i].name, got);
+         status = 1;
+      }
+   }
+   if (single(212.0f) != 100.0f) {
+      fprintf(stderr, "f_to_c_f(212.0f) = %f, want 100.0\n", single(212.0f));
+      status = 1;
+   }
+   batch(in, out, 3);
+   if (out[0] != 0 || out[1] != 100 || out[2] != -40) {
+      fprintf(stderr, "f_to_c_batch = {%f, %f, %f}, want {0, 100, -40}\n",
+              out[0], out[1], out[2]);
+      status = 1;
+   }
+   inplace(in, 3);
+   if (in[0] != 0 || in[1] != 100 || in[2] != -40) {
+      fprintf(stderr, "f_to_c_inplace = {%f, %f, %f}, want {0, 100, -40}\n",
+              in[0], in[1], in[2]);
+      status = 1;
+   }
+   return status;
+}
@@ -0,0 +1,52 @@
+/* Links against the c-shared build of cmd/libtempconv and checks FToC from
+ * several threads at once. Build and run with `make test-cshared`. */
+#include <math.h>
+#include <pthread.h>
+#include <stdio.h>
+
+#include "libtempconv.h"
+
+#define THREADS 8
+#define CALLS 10000
+
+static void* convert_boiling(void* arg) {
+   int* failed = arg;
+   int i;
+   for (i = 0; i < CALLS; i++) {
+      if (FToC(212.0) != 100.0) {
+         *failed = 1;
+         break;
+      }
+   }
+   return NULL;
+}
+
+int main(void) {
+   pthread_t threads[THREADS];
+   int failed[THREADS] = {0};
+   int i, status = 0;
+
+   if (FToC(212.0) != 100.0) {
+      fprintf(stderr, "FToC(212.0) = %f, want 100.0\n", FToC(212.0));
+      return 1;
+   }
+   if (!isnan(FToC(-500.0))) {
+      fprintf(stderr, "FToC(-500.0) = %f, want NaN\n", FToC(-500.0));
+      return 1;
+   }
+
+   for (i = 0; i < THREADS; i++) {
+      pthread_create(&threads[i], NULL, convert_boiling, &failed[i]);
+   }
+   for (i = 0; i < THREADS; i++) {
+      pthread_join(threads[i], NULL);
+      if (failed[i]) {
+         fprintf(stderr, "thread %d: FToC(212.0) != 100.0\n", i);
+         status = 1;
+      }
+   }
+   if (status == 0) {
+      printf("ok\n");
+   }
+   return status;
+}